@@ -1,18 +1,19 @@
 package cmd
 
 import (
-	log "github.com/sirupsen/logrus"
+	"errors"
 	"github.com/spf13/cobra"
 
 	"github.com/qovery/qovery-cli/pkg"
+	"github.com/qovery/qovery-cli/utils"
 )
 
 var (
 	adminDeleteClusterCmd = &cobra.Command{
 		Use:   "force-delete-cluster",
 		Short: "Force delete cluster by id (only Qovery DB side, without calling the engine)",
-		Run: func(cmd *cobra.Command, args []string) {
-			deleteClusterById()
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return deleteClusterById()
 		},
 	}
 )
@@ -24,10 +25,10 @@ func init() {
 	adminCmd.AddCommand(adminDeleteClusterCmd)
 }
 
-func deleteClusterById() {
+func deleteClusterById() error {
 	if orgaErr != nil {
-		log.Error("Invalid cluster Id")
+		return &utils.UsageError{Err: errors.New("Invalid cluster Id")}
 	} else {
-		pkg.DeleteClusterById(clusterId, dryRun)
+		return pkg.DeleteClusterById(clusterId, dryRun)
 	}
 }
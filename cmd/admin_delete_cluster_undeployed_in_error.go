@@ -10,8 +10,8 @@ var (
 	adminDeleteClusterUnDeployedInErrorCmd = &cobra.Command{
 		Use:   "delete-cluster-undeployed-in-error",
 		Short: "Trigger deletion of all clusters not deployed once and that are in error",
-		Run: func(cmd *cobra.Command, args []string) {
-			deleteClusterUnDeployedInError()
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return deleteClusterUnDeployedInError()
 		},
 	}
 )
@@ -20,6 +20,6 @@ func init() {
 	adminCmd.AddCommand(adminDeleteClusterUnDeployedInErrorCmd)
 }
 
-func deleteClusterUnDeployedInError() {
-	pkg.DeleteClusterUnDeployedInError()
+func deleteClusterUnDeployedInError() error {
+	return pkg.DeleteClusterUnDeployedInError()
 }
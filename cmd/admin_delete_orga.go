@@ -1,8 +1,9 @@
 package cmd
 
 import (
+	"errors"
 	"github.com/qovery/qovery-cli/pkg"
-	log "github.com/sirupsen/logrus"
+	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -10,8 +11,8 @@ var (
 	adminDeleteOrgaCmd = &cobra.Command{
 		Use:   "delete",
 		Short: "Delete organization by the cluster's id it owns",
-		Run: func(cmd *cobra.Command, args []string) {
-			deleteOrganizationByClusterId()
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return deleteOrganizationByClusterId()
 		},
 	}
 )
@@ -23,10 +24,10 @@ func init() {
 	adminCmd.AddCommand(adminDeleteOrgaCmd)
 }
 
-func deleteOrganizationByClusterId() {
+func deleteOrganizationByClusterId() error {
 	if orgaErr != nil {
-		log.Error("Invalid cluster Id")
+		return &utils.UsageError{Err: errors.New("Invalid cluster Id")}
 	} else {
-		pkg.DeleteOrganizationByClusterId(clusterId, dryRun)
+		return pkg.DeleteOrganizationByClusterId(clusterId, dryRun)
 	}
 }
@@ -1,8 +1,9 @@
 package cmd
 
 import (
+	"errors"
 	"github.com/qovery/qovery-cli/pkg"
-	log "github.com/sirupsen/logrus"
+	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -10,8 +11,8 @@ var (
 	adminDeleteProjectCmd = &cobra.Command{
 		Use:   "force-delete-project",
 		Short: "Force delete project by id (only Qovery DB side, without calling the engine)",
-		Run: func(cmd *cobra.Command, args []string) {
-			deleteProjectById()
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return deleteProjectById()
 		},
 	}
 )
@@ -23,10 +24,10 @@ func init() {
 	adminCmd.AddCommand(adminDeleteProjectCmd)
 }
 
-func deleteProjectById() {
+func deleteProjectById() error {
 	if orgaErr != nil {
-		log.Error("Invalid project Id")
+		return &utils.UsageError{Err: errors.New("Invalid project Id")}
 	} else {
-		pkg.DeleteProjectById(projectId, dryRun)
+		return pkg.DeleteProjectById(projectId, dryRun)
 	}
 }
@@ -1,8 +1,9 @@
 package cmd
 
 import (
+	"errors"
 	"github.com/qovery/qovery-cli/pkg"
-	log "github.com/sirupsen/logrus"
+	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -10,8 +11,8 @@ var (
 	adminDeployByIdCmd = &cobra.Command{
 		Use:   "deploy",
 		Short: "Deploy cluster with its Id",
-		Run: func(cmd *cobra.Command, args []string) {
-			deployClusterById()
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return deployClusterById()
 		},
 	}
 )
@@ -23,10 +24,10 @@ func init() {
 	adminCmd.AddCommand(adminDeployByIdCmd)
 }
 
-func deployClusterById() {
+func deployClusterById() error {
 	if orgaErr != nil {
-		log.Error("Invalid cluster Id")
+		return &utils.UsageError{Err: errors.New("Invalid cluster Id")}
 	} else {
-		pkg.DeployById(clusterId, dryRun)
+		return pkg.DeployById(clusterId, dryRun)
 	}
 }
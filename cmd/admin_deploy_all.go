@@ -9,8 +9,8 @@ var (
 	adminDeployAllCmd = &cobra.Command{
 		Use:   "deploy-all",
 		Short: "Deploy all customers clusters",
-		Run: func(cmd *cobra.Command, args []string) {
-			deployAllClusters()
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return deployAllClusters()
 		},
 	}
 )
@@ -20,6 +20,6 @@ func init() {
 	adminCmd.AddCommand(adminDeployAllCmd)
 }
 
-func deployAllClusters() {
-	pkg.DeployAll(dryRun)
+func deployAllClusters() error {
+	return pkg.DeployAll(dryRun)
 }
@@ -9,8 +9,8 @@ var (
 	adminDeployFailedClustersCmd = &cobra.Command{
 		Use:   "deploy-failed-clusters",
 		Short: "Deploy all clusters that are in failed state",
-		Run: func(cmd *cobra.Command, args []string) {
-			deployFailedClusters()
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return deployFailedClusters()
 		},
 	}
 )
@@ -19,6 +19,6 @@ func init() {
 	adminCmd.AddCommand(adminDeployFailedClustersCmd)
 }
 
-func deployFailedClusters() {
-	pkg.DeployFailedClusters()
+func deployFailedClusters() error {
+	return pkg.DeployFailedClusters()
 }
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"os/exec"
 
@@ -13,8 +15,8 @@ import (
 var k9sCmd = &cobra.Command{
 	Use:   "k9s",
 	Short: "Launch k9s with a cluster ID",
-	Run: func(cmd *cobra.Command, args []string) {
-		launchK9s(args)
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return launchK9s(args)
 	},
 }
 
@@ -22,24 +24,30 @@ func init() {
 	adminCmd.AddCommand(k9sCmd)
 }
 
-func launchK9s(args []string) {
-	checkEnv()
+func launchK9s(args []string) error {
+	if err := checkEnv(); err != nil {
+		return err
+	}
 
 	if len(args) == 0 {
-		log.Error("You must enter a cluster ID.")
-		return
+		return &utils.UsageError{Err: errors.New("You must enter a cluster ID.")}
 	}
 
 	clusterId := args[0]
-	vars := pkg.GetVarsByClusterId(clusterId)
+	vars, err := pkg.GetVarsByClusterId(clusterId)
+	if err != nil {
+		return err
+	}
 	if len(vars) == 0 {
-		return
+		return nil
 	}
 
 	for _, variable := range vars {
 		os.Setenv(variable.Key, variable.Value)
 	}
-	utils.GenerateExportEnvVarsScript(vars, args[0])
+	if err := utils.GenerateExportEnvVarsScript(vars, args[0]); err != nil {
+		return err
+	}
 
 	log.Info("Launching k9s.")
 	cmd := exec.Command("k9s")
@@ -47,24 +55,23 @@ func launchK9s(args []string) {
 	cmd.Stdin = os.Stdin
 	cmd.Stderr = os.Stderr
 
-	err := cmd.Run()
+	err = cmd.Run()
+	utils.DeleteFolder(os.Getenv("KUBECONFIG")[0 : len(os.Getenv("KUBECONFIG"))-len("kubeconfig")])
 	if err != nil {
-		log.Error("Can't launch k9s : " + err.Error())
+		return fmt.Errorf("Can't launch k9s : %s", err)
 	}
 
-	utils.DeleteFolder(os.Getenv("KUBECONFIG")[0 : len(os.Getenv("KUBECONFIG"))-len("kubeconfig")])
+	return nil
 }
 
-func checkEnv() {
+func checkEnv() error {
 	if _, ok := os.LookupEnv("VAULT_ADDR"); !ok {
-		log.Error("You must set vault address env variable (VAULT_ADDR).")
-		os.Exit(1)
-		panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+		return &utils.UsageError{Err: errors.New("You must set vault address env variable (VAULT_ADDR).")}
 	}
 
 	if _, ok := os.LookupEnv("VAULT_TOKEN"); !ok {
-		log.Error("You must set vault token env variable (VAULT_TOKEN).")
-		os.Exit(1)
-		panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+		return &utils.UsageError{Err: errors.New("You must set vault token env variable (VAULT_TOKEN).")}
 	}
+
+	return nil
 }
@@ -1,8 +1,9 @@
 package cmd
 
 import (
+	"errors"
 	"github.com/qovery/qovery-cli/pkg"
-	log "github.com/sirupsen/logrus"
+	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -10,8 +11,8 @@ var (
 	adminLockByIdCmd = &cobra.Command{
 		Use:   "lock",
 		Short: "Lock a cluster with its Id",
-		Run: func(cmd *cobra.Command, args []string) {
-			lockClusterById()
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return lockClusterById()
 		},
 	}
 )
@@ -24,10 +25,10 @@ func init() {
 	adminCmd.AddCommand(adminLockByIdCmd)
 }
 
-func lockClusterById() {
+func lockClusterById() error {
 	if orgaErr != nil {
-		log.Error("Invalid cluster Id")
+		return &utils.UsageError{Err: errors.New("Invalid cluster Id")}
 	} else {
-		pkg.LockById(clusterId, lockReason)
+		return pkg.LockById(clusterId, lockReason)
 	}
 }
@@ -1,8 +1,9 @@
 package cmd
 
 import (
+	"errors"
 	"github.com/qovery/qovery-cli/pkg"
-	log "github.com/sirupsen/logrus"
+	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -10,8 +11,8 @@ var (
 	adminLockedClustersCmd = &cobra.Command{
 		Use:   "locked",
 		Short: "List locked clusters",
-		Run: func(cmd *cobra.Command, args []string) {
-			lockedClusters()
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return lockedClusters()
 		},
 	}
 )
@@ -20,10 +21,10 @@ func init() {
 	adminCmd.AddCommand(adminLockedClustersCmd)
 }
 
-func lockedClusters() {
+func lockedClusters() error {
 	if orgaErr != nil {
-		log.Error("Invalid cluster Id")
+		return &utils.UsageError{Err: errors.New("Invalid cluster Id")}
 	} else {
-		pkg.LockedClusters()
+		return pkg.LockedClusters()
 	}
 }
@@ -1,8 +1,9 @@
 package cmd
 
 import (
+	"errors"
 	"github.com/qovery/qovery-cli/pkg"
-	log "github.com/sirupsen/logrus"
+	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -10,8 +11,8 @@ var (
 	adminUnlockByIdCmd = &cobra.Command{
 		Use:   "unlock",
 		Short: "Unlock a cluster with its Id",
-		Run: func(cmd *cobra.Command, args []string) {
-			unlockClusterById()
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return unlockClusterById()
 		},
 	}
 )
@@ -22,10 +23,10 @@ func init() {
 	adminCmd.AddCommand(adminUnlockByIdCmd)
 }
 
-func unlockClusterById() {
+func unlockClusterById() error {
 	if orgaErr != nil {
-		log.Error("Invalid cluster Id")
+		return &utils.UsageError{Err: errors.New("Invalid cluster Id")}
 	} else {
-		pkg.UnockById(clusterId)
+		return pkg.UnockById(clusterId)
 	}
 }
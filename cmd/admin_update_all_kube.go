@@ -1,8 +1,9 @@
 package cmd
 
 import (
+	"errors"
 	"github.com/qovery/qovery-cli/pkg"
-	log "github.com/sirupsen/logrus"
+	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -13,8 +14,8 @@ var (
 	adminUpdateAllCmd = &cobra.Command{
 		Use:   "update-bulk",
 		Short: "Update an amount of clusters to a specific version based on cloud provider kind.",
-		Run: func(cmd *cobra.Command, args []string) {
-			updateAllClusters()
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updateAllClusters()
 		},
 	}
 )
@@ -29,20 +30,17 @@ func init() {
 	adminCmd.AddCommand(adminUpdateAllCmd)
 }
 
-func updateAllClusters() {
+func updateAllClusters() error {
 	if versionErr != nil {
-		log.Error("Invalid version")
-		return
+		return &utils.UsageError{Err: errors.New("Invalid version")}
 	}
 	if providerErr != nil {
-		log.Error("Provider kind is mandatory")
-		return
+		return &utils.UsageError{Err: errors.New("Provider kind is mandatory")}
 	}
 
 	if parallelRun > 20 {
-		log.Error("Can't update more than 20 clusters")
-		return
+		return &utils.UsageError{Err: errors.New("Can't update more than 20 clusters")}
 	}
 
-	pkg.UpdateAll(dryRun, version, providerKind, parallelRun)
+	return pkg.UpdateAll(dryRun, version, providerKind, parallelRun)
 }
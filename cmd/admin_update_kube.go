@@ -1,8 +1,9 @@
 package cmd
 
 import (
+	"errors"
 	"github.com/qovery/qovery-cli/pkg"
-	log "github.com/sirupsen/logrus"
+	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -10,8 +11,8 @@ var (
 	adminUpdateByIdCmd = &cobra.Command{
 		Use:   "update",
 		Short: "Update cluster with its Id to a specific version",
-		Run: func(cmd *cobra.Command, args []string) {
-			updateClusterById()
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updateClusterById()
 		},
 	}
 )
@@ -25,14 +26,12 @@ func init() {
 	adminCmd.AddCommand(adminUpdateByIdCmd)
 }
 
-func updateClusterById() {
+func updateClusterById() error {
 	if orgaErr != nil {
-		log.Error("Invalid cluster Id")
-		return
+		return &utils.UsageError{Err: errors.New("Invalid cluster Id")}
 	}
 	if versionErr != nil {
-		log.Error("Invalid version")
-		return
+		return &utils.UsageError{Err: errors.New("Invalid version")}
 	}
-	pkg.UpdateById(clusterId, dryRun, version)
+	return pkg.UpdateById(clusterId, dryRun, version)
 }
@@ -1,11 +1,13 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"time"
 
+	"github.com/qovery/qovery-cli/utils"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -13,8 +15,8 @@ import (
 var vaultTokenCmd = &cobra.Command{
 	Use:   "vault-token",
 	Short: "Get Vault Token",
-	Run: func(cmd *cobra.Command, args []string) {
-		getAndShowVaultToken(args)
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return getAndShowVaultToken(args)
 	},
 }
 
@@ -22,29 +24,38 @@ func init() {
 	adminCmd.AddCommand(vaultTokenCmd)
 }
 
-func getAndShowVaultToken(args []string) {
-	tokenFilePath, vaultToken := getVaultToken(args)
+func getAndShowVaultToken(args []string) error {
+	tokenFilePath, vaultToken, err := getVaultToken(args)
+	if err != nil {
+		return err
+	}
+
 	log.Info(fmt.Sprintf("Your Vault Token (%s):\n%s", tokenFilePath, vaultToken))
+	return nil
 }
 
-func getTokenFilePath() string {
+func getTokenFilePath() (string, error) {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
-		log.Error("Can't get home directory")
-		os.Exit(1)
-		panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+		return "", fmt.Errorf("Can't get home directory: %s", err)
 	}
-	return fmt.Sprintf("%s/.vault-token", homeDir)
+	return fmt.Sprintf("%s/.vault-token", homeDir), nil
 }
 
-func getVaultToken(args []string) (string, string) {
+func getVaultToken(args []string) (string, string, error) {
 	var tokenFileModificationTime time.Time
 	tokenValiditySec := 43200
 	renewBeforeSec := 7200
 	maxTokenValidity := tokenValiditySec - renewBeforeSec
-	tokenFilePath := getTokenFilePath()
+	tokenFilePath, err := getTokenFilePath()
+	if err != nil {
+		return "", "", err
+	}
 
-	vaultPath, ghToken := checkVaultEnv()
+	vaultPath, ghToken, err := checkVaultEnv()
+	if err != nil {
+		return "", "", err
+	}
 
 	// check if token file exists
 	fileStat, err := os.Stat(tokenFilePath)
@@ -61,50 +72,37 @@ func getVaultToken(args []string) (string, string) {
 		cmd := exec.Command(vaultPath, "login", "-token-only", "-method=github", fmt.Sprintf("token=%s", ghToken))
 		secret, err := cmd.CombinedOutput()
 		if err != nil {
-			log.Error("error with Vault: " + err.Error())
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return "", "", fmt.Errorf("error with Vault: %s", err)
 		}
 
 		err = os.WriteFile(tokenFilePath, []byte(secret), 0600)
 		if err != nil {
-			log.Error(fmt.Sprintf("error while writing token to vault token file (%s)", tokenFilePath))
-			log.Error(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return "", "", fmt.Errorf("error while writing token to vault token file (%s): %s", tokenFilePath, err)
 		}
 	}
 
 	vaultToken, err := os.ReadFile(tokenFilePath)
 	if err != nil {
-		log.Error(fmt.Sprintf("can't read file %s", tokenFilePath))
-		os.Exit(1)
-		panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+		return "", "", fmt.Errorf("can't read file %s", tokenFilePath)
 	}
 
-	return tokenFilePath, string(vaultToken)
+	return tokenFilePath, string(vaultToken), nil
 }
 
-func checkVaultEnv() (string, string) {
+func checkVaultEnv() (string, string, error) {
 	if _, ok := os.LookupEnv("VAULT_ADDR"); !ok {
-		log.Error("You must set vault address env variable (VAULT_ADDR).")
-		os.Exit(1)
-		panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+		return "", "", &utils.UsageError{Err: errors.New("You must set vault address env variable (VAULT_ADDR).")}
 	}
 
-	ghToken, err := os.LookupEnv("VAULT_GH_TOKEN")
-	if !err {
-		log.Error("You must set your personal token env variable (VAULT_GH_TOKEN).")
-		os.Exit(1)
-		panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+	ghToken, ok := os.LookupEnv("VAULT_GH_TOKEN")
+	if !ok {
+		return "", "", &utils.UsageError{Err: errors.New("You must set your personal token env variable (VAULT_GH_TOKEN).")}
 	}
 
-	vaultPath, e := exec.LookPath("vault")
-	if e != nil {
-		log.Error("vault binary is not found in your path")
-		os.Exit(1)
-		panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+	vaultPath, err := exec.LookPath("vault")
+	if err != nil {
+		return "", "", errors.New("vault binary is not found in your path")
 	}
 
-	return vaultPath, ghToken
+	return vaultPath, ghToken, nil
 }
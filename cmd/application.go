@@ -3,7 +3,6 @@ package cmd
 import (
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
-	"os"
 )
 
 var applicationName string
@@ -16,13 +15,14 @@ var applicationCustomDomain string
 var applicationCmd = &cobra.Command{
 	Use:   "application",
 	Short: "Manage applications",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		if len(args) == 0 {
-			_ = cmd.Help()
-			os.Exit(0)
+			return cmd.Help()
 		}
+
+		return nil
 	},
 }
 
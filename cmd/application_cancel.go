@@ -6,71 +6,61 @@ import (
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
-	"os"
 )
 
 var applicationCancelCmd = &cobra.Command{
 	Use:   "cancel",
 	Short: "Cancel an application deployment",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		applications, _, err := client.ApplicationsApi.ListApplication(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		application := utils.FindByApplicationName(applications.GetResults(), applicationName)
 
 		if application == nil {
-			utils.PrintlnError(fmt.Errorf("application %s not found", applicationName))
-			utils.PrintlnInfo("You can list all applications with: qovery application list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "application", Name: applicationName, Candidates: utils.ApplicationNames(applications.GetResults())}, "You can list all applications with: qovery application list")
 		}
 
 		msg, err := utils.CancelServiceDeployment(client, envId, application.Id, utils.ApplicationType, watchFlag)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if msg != "" {
 			utils.PrintlnInfo(msg)
-			return
+			return nil
 		}
 
 		utils.Println(fmt.Sprintf("Application %s deployment cancelled!", pterm.FgBlue.Sprintf(applicationName)))
+
+		return nil
 	},
 }
 
 func init() {
 	applicationCmd.AddCommand(applicationCancelCmd)
-	applicationCancelCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	applicationCancelCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	applicationCancelCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	applicationCancelCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name")
+	applicationCancelCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	applicationCancelCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	applicationCancelCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	applicationCancelCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name or ID")
 	applicationCancelCmd.Flags().BoolVarP(&watchFlag, "watch", "w", false, "Watch cancel until it's done or an error occurs")
 
 	_ = applicationCancelCmd.MarkFlagRequired("application")
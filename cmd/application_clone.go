@@ -3,8 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"io"
-	"os"
 
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
@@ -15,56 +13,43 @@ import (
 var applicationCloneCmd = &cobra.Command{
 	Use:   "clone",
 	Short: "Clone an application",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		applications, _, err := client.ApplicationsApi.ListApplication(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		application := utils.FindByApplicationName(applications.GetResults(), applicationName)
 
 		if application == nil {
-			utils.PrintlnError(fmt.Errorf("application %s not found", applicationName))
-			utils.PrintlnInfo("You can list all applications with: qovery application list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "application", Name: applicationName, Candidates: utils.ApplicationNames(applications.GetResults())}, "You can list all applications with: qovery application list")
 		}
 
 		sourceEnvironment, _, err := client.EnvironmentMainCallsApi.GetEnvironment(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		environments, _, err := client.EnvironmentsApi.ListEnvironment(context.Background(), sourceEnvironment.Project.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if targetEnvironmentName == "" {
@@ -75,9 +60,7 @@ var applicationCloneCmd = &cobra.Command{
 		targetEnvironment := utils.FindByEnvironmentName(environments.GetResults(), targetEnvironmentName)
 
 		if targetEnvironment == nil {
-			utils.PrintlnError(fmt.Errorf("environment %s not found", targetEnvironmentName))
-			utils.PrintlnInfo("You can list all environments with: qovery environment list")
-			os.Exit(1)
+			return utils.WithHint(&utils.NotFoundError{Level: "environment", Name: targetEnvironmentName, Candidates: utils.EnvironmentNames(environments.GetResults())}, "You can list all environments with: qovery environment list")
 		}
 
 		var storage []qovery.ServiceStorageRequestStorageInner
@@ -136,58 +119,49 @@ var applicationCloneCmd = &cobra.Command{
 			Entrypoint:          application.Entrypoint,
 		}
 
-		createdService, res, err := client.ApplicationsApi.CreateApplication(context.Background(), targetEnvironment.Id).ApplicationRequest(req).Execute()
+		createdService, _, err := client.ApplicationsApi.CreateApplication(context.Background(), targetEnvironment.Id).ApplicationRequest(req).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-
-			bodyBytes, err := io.ReadAll(res.Body)
-			if err != nil {
-				return
-			}
-
-			utils.PrintlnError(fmt.Errorf("unable to clone application %s", string(bodyBytes)))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			// the body of the response, telling why the clone was rejected, is printed along the error
+			return err
 		}
 
-		deploymentStageId := utils.GetDeploymentStageId(client, application.Id)
+		deploymentStageId, err := utils.GetDeploymentStageId(client, application.Id)
+		if err != nil {
+			return err
+		}
 
 		_, _, err = client.DeploymentStageMainCallsApi.AttachServiceToDeploymentStage(context.Background(), deploymentStageId, createdService.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		// clone advanced settings
 		settings, _, err := client.ApplicationConfigurationApi.GetAdvancedSettings(context.Background(), application.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		_, _, err = client.ApplicationConfigurationApi.EditAdvancedSettings(context.Background(), createdService.Id).ApplicationAdvancedSettings(*settings).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Application %s cloned!", pterm.FgBlue.Sprintf(applicationName)))
+
+		return nil
 	},
 }
 
 func init() {
 	applicationCmd.AddCommand(applicationCloneCmd)
-	applicationCloneCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	applicationCloneCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	applicationCloneCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	applicationCloneCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name")
+	applicationCloneCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	applicationCloneCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	applicationCloneCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	applicationCloneCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name or ID")
 	applicationCloneCmd.Flags().StringVarP(&targetEnvironmentName, "target-environment", "", "", "Target Environment Name")
 	applicationCloneCmd.Flags().StringVarP(&targetApplicationName, "target-application-name", "", "", "Target Application Name")
 
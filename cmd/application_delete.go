@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
@@ -13,71 +12,60 @@ import (
 var applicationDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete an application",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if !utils.IsEnvironmentInATerminalState(envId, client) {
-			utils.PrintlnError(fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
-				"for the end of the current operation to run your command. Try again in a few moment", envId))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
+				"for the end of the current operation to run your command. Try again in a few moment", envId)
 		}
 
 		applications, _, err := client.ApplicationsApi.ListApplication(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		application := utils.FindByApplicationName(applications.GetResults(), applicationName)
 
 		if application == nil {
-			utils.PrintlnError(fmt.Errorf("application %s not found", applicationName))
-			utils.PrintlnInfo("You can list all applications with: qovery application list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "application", Name: applicationName, Candidates: utils.ApplicationNames(applications.GetResults())}, "You can list all applications with: qovery application list")
 		}
 
 		_, err = client.ApplicationMainCallsApi.DeleteApplication(context.Background(), application.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Deleting application %s in progress..", pterm.FgBlue.Sprintf(applicationName)))
 
 		if watchFlag {
-			utils.WatchApplication(application.Id, envId, client)
+			return utils.WatchApplication(application.Id, envId, client)
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	applicationCmd.AddCommand(applicationDeleteCmd)
-	applicationDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	applicationDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	applicationDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	applicationDeleteCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name")
+	applicationDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	applicationDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	applicationDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	applicationDeleteCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name or ID")
 	applicationDeleteCmd.Flags().BoolVarP(&watchFlag, "watch", "w", false, "Watch application status until it's ready or an error occurs")
 
 	_ = applicationDeleteCmd.MarkFlagRequired("application")
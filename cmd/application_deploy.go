@@ -7,48 +7,37 @@ import (
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/qovery/qovery-client-go"
 	"github.com/spf13/cobra"
-	"os"
 )
 
 var applicationDeployCmd = &cobra.Command{
 	Use:   "deploy",
 	Short: "Deploy an application",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if applicationName == "" && applicationNames == "" {
-			utils.PrintlnError(fmt.Errorf("use neither --application \"<app name>\" nor --applications \"<app1 name>, <app2 name>\""))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("use neither --application \"<app name>\" nor --applications \"<app1 name>, <app2 name>\"")
 		}
 
 		if applicationName != "" && applicationNames != "" {
-			utils.PrintlnError(fmt.Errorf("you can't use --application and --applications at the same time"))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("you can't use --application and --applications at the same time")
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if !utils.IsEnvironmentInATerminalState(envId, client) {
-			utils.PrintlnError(fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
-				"for the end of the current operation to run your command. Try again in a few moment", envId))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
+				"for the end of the current operation to run your command. Try again in a few moment", envId)
 		}
 
 		if applicationNames != "" {
@@ -56,35 +45,28 @@ var applicationDeployCmd = &cobra.Command{
 			err := utils.DeployApplications(client, envId, applicationNames, applicationCommitId)
 
 			if err != nil {
-				utils.PrintlnError(err)
-				os.Exit(1)
-				panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+				return err
 			}
 
 			utils.Println(fmt.Sprintf("Deploying applications %s in progress..", pterm.FgBlue.Sprintf(applicationNames)))
 
 			if watchFlag {
-				utils.WatchEnvironment(envId, "unused", client)
+				return utils.WatchEnvironment(envId, "unused", client)
 			}
 
-			return
+			return nil
 		}
 
 		applications, _, err := client.ApplicationsApi.ListApplication(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		application := utils.FindByApplicationName(applications.GetResults(), applicationName)
 
 		if application == nil {
-			utils.PrintlnError(fmt.Errorf("application %s not found", applicationName))
-			utils.PrintlnInfo("You can list all applications with: qovery application list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "application", Name: applicationName, Candidates: utils.ApplicationNames(applications.GetResults())}, "You can list all applications with: qovery application list")
 		}
 
 		req := qovery.DeployRequest{
@@ -98,25 +80,26 @@ var applicationDeployCmd = &cobra.Command{
 		_, _, err = client.ApplicationActionsApi.DeployApplication(context.Background(), application.Id).DeployRequest(req).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Deploying application %s in progress..", pterm.FgBlue.Sprintf(applicationName)))
 
 		if watchFlag {
-			utils.WatchApplication(application.Id, envId, client)
+			return utils.WatchApplication(application.Id, envId, client)
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	applicationCmd.AddCommand(applicationDeployCmd)
-	applicationDeployCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	applicationDeployCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	applicationDeployCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	applicationDeployCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name")
+	applicationDeployCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	applicationDeployCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	applicationDeployCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	applicationDeployCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name or ID")
+	applicationDeployCmd.Flags().StringVarP(&consoleUrl, "url", "", "", "Qovery console URL of the resource, instead of its names")
 	applicationDeployCmd.Flags().StringVarP(&applicationNames, "applications", "", "", "Application Names (comma separated) Example: --applications \"app1,app2,app3\"")
 	applicationDeployCmd.Flags().StringVarP(&applicationCommitId, "commit-id", "c", "", "Application Commit ID")
 	applicationDeployCmd.Flags().BoolVarP(&watchFlag, "watch", "w", false, "Watch application status until it's ready or an error occurs")
@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
@@ -13,75 +12,62 @@ import (
 var applicationDomainDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete application custom domain",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		applications, _, err := client.ApplicationsApi.ListApplication(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		application := utils.FindByApplicationName(applications.GetResults(), applicationName)
 
 		if application == nil {
-			utils.PrintlnError(fmt.Errorf("application %s not found", applicationName))
-			utils.PrintlnInfo("You can list all applications with: qovery application list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "application", Name: applicationName, Candidates: utils.ApplicationNames(applications.GetResults())}, "You can list all applications with: qovery application list")
 		}
 
 		customDomains, _, err := client.CustomDomainApi.ListApplicationCustomDomain(context.Background(), application.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		customDomain := utils.FindByCustomDomainName(customDomains.GetResults(), applicationCustomDomain)
 		if customDomain == nil {
-			utils.PrintlnError(fmt.Errorf("custom domain %s does not exist", applicationCustomDomain))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return &utils.NotFoundError{Level: "custom domain", Name: applicationCustomDomain, Candidates: utils.CustomDomainNames(customDomains.GetResults())}
 		}
 
 		_, err = client.CustomDomainApi.DeleteCustomDomain(context.Background(), application.Id, customDomain.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Custom domain %s has been deleted", pterm.FgBlue.Sprintf(applicationCustomDomain)))
+
+		return nil
 	},
 }
 
 func init() {
 	applicationDomainCmd.AddCommand(applicationDomainDeleteCmd)
-	applicationDomainDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	applicationDomainDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	applicationDomainDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	applicationDomainDeleteCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name")
+	applicationDomainDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	applicationDomainDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	applicationDomainDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	applicationDomainDeleteCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name or ID")
 	applicationDomainDeleteCmd.Flags().StringVarP(&applicationCustomDomain, "domain", "", "", "Custom Domain <subdomain.domain.tld>")
 
 	_ = applicationDomainDeleteCmd.MarkFlagRequired("application")
@@ -2,8 +2,6 @@ package cmd
 
 import (
 	"context"
-	"fmt"
-	"os"
 	"strings"
 
 	"github.com/qovery/qovery-cli/utils"
@@ -13,14 +11,12 @@ import (
 var applicationDomainListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List application domains",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
@@ -28,34 +24,25 @@ var applicationDomainListCmd = &cobra.Command{
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		applications, _, err := client.ApplicationsApi.ListApplication(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		application := utils.FindByApplicationName(applications.GetResults(), applicationName)
 
 		if application == nil {
-			utils.PrintlnError(fmt.Errorf("application %s not found", applicationName))
-			utils.PrintlnInfo("You can list all applications with: qovery application list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "application", Name: applicationName, Candidates: utils.ApplicationNames(applications.GetResults())}, "You can list all applications with: qovery application list")
 		}
 
 		customDomains, _, err := client.CustomDomainApi.ListApplicationCustomDomain(context.Background(), application.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		customDomainsSet := make(map[string]bool)
@@ -74,9 +61,7 @@ var applicationDomainListCmd = &cobra.Command{
 		links, _, err := client.ApplicationMainCallsApi.ListApplicationLinks(context.Background(), application.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		for _, link := range links.GetResults() {
@@ -95,19 +80,19 @@ var applicationDomainListCmd = &cobra.Command{
 		err = utils.PrintTable([]string{"Type", "Domain", "Validation Domain"}, data)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	applicationDomainCmd.AddCommand(applicationDomainListCmd)
-	applicationDomainListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	applicationDomainListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	applicationDomainListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	applicationDomainListCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name")
+	applicationDomainListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	applicationDomainListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	applicationDomainListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	applicationDomainListCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name or ID")
 
 	_ = applicationDomainListCmd.MarkFlagRequired("application")
 }
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var applicationEnvAliasDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete application environment variable or secret alias",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		_, projectId, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		applications, _, err := client.ApplicationsApi.ListApplication(context.Background(), envId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		application := utils.FindByApplicationName(applications.GetResults(), applicationName)
+
+		if application == nil {
+			return utils.WithHint(&utils.NotFoundError{Level: "application", Name: applicationName, Candidates: utils.ApplicationNames(applications.GetResults())}, "You can list all applications with: qovery application list")
+		}
+
+		err = utils.DeleteAliasByKey(client, projectId, envId, application.Id, utils.ApplicationType, utils.Alias)
+
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("Alias %s has been deleted", pterm.FgBlue.Sprintf(utils.Alias)))
+
+		return nil
+	},
+}
+
+func init() {
+	applicationEnvAliasCmd.AddCommand(applicationEnvAliasDeleteCmd)
+	applicationEnvAliasDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	applicationEnvAliasDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	applicationEnvAliasDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	applicationEnvAliasDeleteCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name or ID")
+	applicationEnvAliasDeleteCmd.Flags().StringVarP(&utils.Alias, "alias", "", "", "Environment variable or secret alias")
+
+	_ = applicationEnvAliasDeleteCmd.MarkFlagRequired("alias")
+	_ = applicationEnvAliasDeleteCmd.MarkFlagRequired("application")
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var applicationEnvAliasListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List application environment variable and secret aliases",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+
+		_, _, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		applications, _, err := client.ApplicationsApi.ListApplication(context.Background(), envId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		application := utils.FindByApplicationName(applications.GetResults(), applicationName)
+
+		if application == nil {
+			return utils.WithHint(&utils.NotFoundError{Level: "application", Name: applicationName, Candidates: utils.ApplicationNames(applications.GetResults())}, "You can list all applications with: qovery application list")
+		}
+
+		envVars, _, err := client.ApplicationEnvironmentVariableApi.ListApplicationEnvironmentVariable(
+			context.Background(),
+			application.Id,
+		).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		secrets, _, err := client.ApplicationSecretApi.ListApplicationSecrets(
+			context.Background(),
+			application.Id,
+		).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		envVarLines := utils.NewEnvVarLines()
+
+		for _, envVar := range envVars.GetResults() {
+			if envVar.AliasedVariable != nil {
+				envVarLines.Add(utils.FromEnvironmentVariableToEnvVarLineOutput(envVar))
+			}
+		}
+
+		for _, secret := range secrets.GetResults() {
+			if secret.AliasedSecret != nil {
+				envVarLines.Add(utils.FromSecretToEnvVarLineOutput(secret))
+			}
+		}
+
+		err = utils.PrintTable(envVarLines.Header(false), envVarLines.Lines(utils.ShowValues, false))
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	applicationEnvAliasCmd.AddCommand(applicationEnvAliasListCmd)
+	applicationEnvAliasListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	applicationEnvAliasListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	applicationEnvAliasListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	applicationEnvAliasListCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name or ID")
+	applicationEnvAliasListCmd.Flags().BoolVarP(&utils.ShowValues, "show-values", "", false, "Show env var values")
+
+	_ = applicationEnvAliasListCmd.MarkFlagRequired("application")
+}
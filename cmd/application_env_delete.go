@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
@@ -13,60 +12,51 @@ import (
 var applicationEnvDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete application environment variable or secret",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, projectId, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		applications, _, err := client.ApplicationsApi.ListApplication(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		application := utils.FindByApplicationName(applications.GetResults(), applicationName)
 
 		if application == nil {
-			utils.PrintlnError(fmt.Errorf("application %s not found", applicationName))
-			utils.PrintlnInfo("You can list all applications with: qovery application list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "application", Name: applicationName, Candidates: utils.ApplicationNames(applications.GetResults())}, "You can list all applications with: qovery application list")
 		}
 
 		err = utils.DeleteByKey(client, projectId, envId, application.Id, utils.ApplicationType, utils.Key)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Variable %s has been deleted", pterm.FgBlue.Sprintf(utils.Key)))
+
+		return nil
 	},
 }
 
 func init() {
 	applicationEnvCmd.AddCommand(applicationEnvDeleteCmd)
-	applicationEnvDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	applicationEnvDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	applicationEnvDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	applicationEnvDeleteCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name")
+	applicationEnvDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	applicationEnvDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	applicationEnvDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	applicationEnvDeleteCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name or ID")
 	applicationEnvDeleteCmd.Flags().StringVarP(&utils.Key, "key", "k", "", "Environment variable or secret key")
 
 	_ = applicationEnvDeleteCmd.MarkFlagRequired("key")
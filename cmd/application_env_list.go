@@ -2,8 +2,6 @@ package cmd
 
 import (
 	"context"
-	"fmt"
-	"os"
 
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
@@ -12,14 +10,12 @@ import (
 var applicationEnvListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List application environment variables",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
@@ -27,26 +23,19 @@ var applicationEnvListCmd = &cobra.Command{
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		applications, _, err := client.ApplicationsApi.ListApplication(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		application := utils.FindByApplicationName(applications.GetResults(), applicationName)
 
 		if application == nil {
-			utils.PrintlnError(fmt.Errorf("application %s not found", applicationName))
-			utils.PrintlnInfo("You can list all applications with: qovery application list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "application", Name: applicationName, Candidates: utils.ApplicationNames(applications.GetResults())}, "You can list all applications with: qovery application list")
 		}
 
 		envVars, _, err := client.ApplicationEnvironmentVariableApi.ListApplicationEnvironmentVariable(
@@ -55,9 +44,7 @@ var applicationEnvListCmd = &cobra.Command{
 		).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		secrets, _, err := client.ApplicationSecretApi.ListApplicationSecrets(
@@ -66,9 +53,7 @@ var applicationEnvListCmd = &cobra.Command{
 		).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		envVarLines := utils.NewEnvVarLines()
@@ -84,19 +69,20 @@ var applicationEnvListCmd = &cobra.Command{
 		err = utils.PrintTable(envVarLines.Header(utils.PrettyPrint), envVarLines.Lines(utils.ShowValues, utils.PrettyPrint))
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	applicationEnvCmd.AddCommand(applicationEnvListCmd)
-	applicationEnvListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	applicationEnvListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	applicationEnvListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	applicationEnvListCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name")
+	applicationEnvListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	applicationEnvListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	applicationEnvListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	applicationEnvListCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name or ID")
+	applicationEnvListCmd.Flags().StringVarP(&consoleUrl, "url", "", "", "Qovery console URL of the resource, instead of its names")
 	applicationEnvListCmd.Flags().BoolVarP(&utils.ShowValues, "show-values", "", false, "Show env var values")
 	applicationEnvListCmd.Flags().BoolVarP(&utils.PrettyPrint, "pretty-print", "", false, "Pretty print output")
 
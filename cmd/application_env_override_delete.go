@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var applicationEnvOverrideDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete application environment variable or secret override",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		_, projectId, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		applications, _, err := client.ApplicationsApi.ListApplication(context.Background(), envId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		application := utils.FindByApplicationName(applications.GetResults(), applicationName)
+
+		if application == nil {
+			return utils.WithHint(&utils.NotFoundError{Level: "application", Name: applicationName, Candidates: utils.ApplicationNames(applications.GetResults())}, "You can list all applications with: qovery application list")
+		}
+
+		err = utils.DeleteOverrideByKey(client, projectId, envId, application.Id, utils.ApplicationType, utils.Key)
+
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("Override %s has been deleted", pterm.FgBlue.Sprintf(utils.Key)))
+
+		return nil
+	},
+}
+
+func init() {
+	applicationEnvOverrideCmd.AddCommand(applicationEnvOverrideDeleteCmd)
+	applicationEnvOverrideDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	applicationEnvOverrideDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	applicationEnvOverrideDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	applicationEnvOverrideDeleteCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name or ID")
+	applicationEnvOverrideDeleteCmd.Flags().StringVarP(&utils.Key, "key", "k", "", "Environment variable or secret key")
+
+	_ = applicationEnvOverrideDeleteCmd.MarkFlagRequired("key")
+	_ = applicationEnvOverrideDeleteCmd.MarkFlagRequired("application")
+}
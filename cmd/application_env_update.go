@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var applicationEnvUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update application environment variable or secret value",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		_, projectId, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		applications, _, err := client.ApplicationsApi.ListApplication(context.Background(), envId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		application := utils.FindByApplicationName(applications.GetResults(), applicationName)
+
+		if application == nil {
+			return utils.WithHint(&utils.NotFoundError{Level: "application", Name: applicationName, Candidates: utils.ApplicationNames(applications.GetResults())}, "You can list all applications with: qovery application list")
+		}
+
+		value, err := utils.ReadValue(utils.Value, utils.ValueFromFile, utils.ValueFromStdin)
+
+		if err != nil {
+			return err
+		}
+
+		err = utils.UpdateByKey(client, projectId, envId, application.Id, utils.ApplicationType, utils.Key, value)
+
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("Variable %s has been updated", pterm.FgBlue.Sprintf(utils.Key)))
+
+		return nil
+	},
+}
+
+func init() {
+	applicationEnvCmd.AddCommand(applicationEnvUpdateCmd)
+	applicationEnvUpdateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	applicationEnvUpdateCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	applicationEnvUpdateCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	applicationEnvUpdateCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name or ID")
+	applicationEnvUpdateCmd.Flags().StringVarP(&utils.Key, "key", "k", "", "Environment variable or secret key")
+	applicationEnvUpdateCmd.Flags().StringVarP(&utils.Value, "value", "v", "", "Environment variable or secret value")
+	applicationEnvUpdateCmd.Flags().StringVarP(&utils.ValueFromFile, "value-from-file", "", "", "Read the environment variable or secret value from a file")
+	applicationEnvUpdateCmd.Flags().BoolVarP(&utils.ValueFromStdin, "value-from-stdin", "", false, "Read the environment variable or secret value from stdin")
+
+	_ = applicationEnvUpdateCmd.MarkFlagRequired("key")
+	_ = applicationEnvUpdateCmd.MarkFlagRequired("application")
+}
@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
@@ -14,40 +13,31 @@ import (
 var applicationUpdateCmd = &cobra.Command{
 	Use:   "update",
 	Short: "Update an application",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		applications, _, err := client.ApplicationsApi.ListApplication(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		application := utils.FindByApplicationName(applications.GetResults(), applicationName)
 
 		if application == nil {
-			utils.PrintlnError(fmt.Errorf("application %s not found", applicationName))
-			utils.PrintlnInfo("You can list all applications with: qovery application list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "application", Name: applicationName, Candidates: utils.ApplicationNames(applications.GetResults())}, "You can list all applications with: qovery application list")
 		}
 
 		var storage []qovery.ServiceStorageRequestStorageInner
@@ -87,21 +77,21 @@ var applicationUpdateCmd = &cobra.Command{
 		_, _, err = client.ApplicationMainCallsApi.EditApplication(context.Background(), application.Id).ApplicationEditRequest(req).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Application %s updated!", pterm.FgBlue.Sprintf(applicationName)))
+
+		return nil
 	},
 }
 
 func init() {
 	applicationCmd.AddCommand(applicationUpdateCmd)
-	applicationUpdateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	applicationUpdateCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	applicationUpdateCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	applicationUpdateCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name")
+	applicationUpdateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	applicationUpdateCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	applicationUpdateCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	applicationUpdateCmd.Flags().StringVarP(&applicationName, "application", "n", "", "Application Name or ID")
 	applicationUpdateCmd.Flags().StringVarP(&applicationBranch, "branch", "", "", "Application Git Branch")
 
 	_ = applicationUpdateCmd.MarkFlagRequired("application")
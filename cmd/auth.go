@@ -2,7 +2,9 @@ package cmd
 
 import (
 	"context"
+	cryptorand "crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -11,10 +13,12 @@ import (
 	"github.com/qovery/qovery-cli/pkg"
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
-	"math/rand"
+	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
+	"runtime"
 	"strconv"
 	"strings"
 	"time"
@@ -25,9 +29,9 @@ var headless bool
 var authCmd = &cobra.Command{
 	Use:   "auth",
 	Short: "Log in to Qovery",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
-		DoRequestUserToAuthenticate(headless)
+		return DoRequestUserToAuthenticate(headless)
 	},
 }
 
@@ -37,112 +41,214 @@ func init() {
 }
 
 const (
-	httpAuthPort   = 10999
-	oAuthQoveryUrl = "https://auth.qovery.com/login?code_challenge_method=S256&scope=%s&client=%s&protocol=oauth2&response_type=%s&audience=%s&redirect_uri=%s&code_challenge=%s"
+	httpAuthPort       = 10999
+	oAuthQoveryUrl     = "https://auth.qovery.com/login?code_challenge_method=S256&scope=%s&client=%s&protocol=oauth2&response_type=%s&audience=%s&redirect_uri=%s&code_challenge=%s&state=%s"
+	browserAuthTimeout = 5 * time.Minute
 )
 
 var (
-	oAuthUrlParamValueClient         = "MJ2SJpu12PxIzgmc5z5Y7N8m5MnaF7Y0"
-	oAuthUrlParamValueHeadlessClient = "f9drkTNpxsEw2VU2PVDrxhyT3vVuFT0Y"
-	oAuthUrlParamValueAudience       = "https://core.qovery.com"
-	oAuthUrlParamValueResponseType   = "code"
-	oAuthUrlParamValueScopes         = "offline_access openid profile email"
-	oAuthUrlParamValueRedirect       = "http://localhost:" + strconv.Itoa(httpAuthPort) + "/authorization"
-	oAuthTokenEndpoint               = "https://auth.qovery.com/oauth/token"
+	oAuthUrlParamValueAudience     = "https://core.qovery.com"
+	oAuthUrlParamValueResponseType = "code"
+	oAuthUrlParamValueScopes       = "offline_access openid profile email"
+	oAuthUrlParamValueRedirect     = "http://localhost:" + strconv.Itoa(httpAuthPort) + "/authorization"
 )
 
-type TokensResponse struct {
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
+type authorizationResult struct {
+	tokens utils.TokensResponse
+	err    error
 }
 
-func DoRequestUserToAuthenticate(headless bool) {
-	qoveryConsoleUrl := "https://console.qovery.com"
-
+func DoRequestUserToAuthenticate(headless bool) error {
 	available, message, _ := pkg.CheckAvailableNewVersion()
 	if available {
 		fmt.Println(message)
 	}
 
-	if headless {
-		runHeadlessFlow()
-		return
+	if headless || !isBrowserAvailable() {
+		return runHeadlessFlow()
+	}
+
+	err := runBrowserFlow()
+	if errors.Is(err, errBrowserUnavailable) {
+		fmt.Println("Can't open a browser, falling back to device authentication. ")
+		return runHeadlessFlow()
+	}
+	if errors.Is(err, errCallbackPortUnavailable) {
+		fmt.Printf("Port %d is already in use, falling back to device authentication. \n", httpAuthPort)
+		return runHeadlessFlow()
+	}
+
+	if err != nil {
+		return &utils.AuthError{Err: err}
+	}
+
+	printSignedIn()
+	return nil
+}
+
+var errBrowserUnavailable = errors.New("browser unavailable")
+
+// only the redirect URI on httpAuthPort is allowed by the authorization server
+var errCallbackPortUnavailable = errors.New("callback port unavailable")
+
+func printSignedIn() {
+	utils.PrintlnInfo("Success!")
+	if warning := utils.TokenStoreWarning(); warning != "" {
+		utils.PrintlnInfo(warning)
+	}
+}
+
+// isBrowserAvailable tells if a browser can be opened on this machine, e.g. not over SSH without X forwarding
+func isBrowserAvailable() bool {
+	if runtime.GOOS == "linux" && os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == "" {
+		return false
+	}
+
+	return os.Getenv("SSH_CONNECTION") == "" || os.Getenv("DISPLAY") != ""
+}
+
+// runBrowserFlow runs the authorization code flow with PKCE. The authorization server redirects the browser to
+// a local server on port 10999.
+func runBrowserFlow() error {
+	qoveryConsoleUrl := "https://console.qovery.com"
+
+	verifier, err := createCodeVerifier()
+	if err != nil {
+		return err
 	}
 
-	verifier := createCodeVerifier()
 	challenge, err := createCodeChallengeS256(verifier)
 	if err != nil {
-		utils.PrintlnError(errors.New("Can not create authorization code challenge. Please contact the #support at 'https://discord.qovery.com'. "))
-		os.Exit(0)
+		return errors.New("Can not create authorization code challenge. Please contact the #support at 'https://discord.qovery.com'. ")
 	}
-	// TODO link to web auth
-	_ = browser.OpenURL(fmt.Sprintf(oAuthQoveryUrl, url.QueryEscape(oAuthUrlParamValueScopes), oAuthUrlParamValueClient, url.QueryEscape(oAuthUrlParamValueResponseType),
-		url.QueryEscape(oAuthUrlParamValueAudience), url.QueryEscape(oAuthUrlParamValueRedirect), challenge))
 
-	fmt.Println("\nOpening your browser, waiting for your authentication... ")
+	state, err := randomUrlSafeString(32)
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", httpAuthPort))
+	if err != nil {
+		return errCallbackPortUnavailable
+	}
 
-	srv := &http.Server{Addr: fmt.Sprintf("localhost:%d", httpAuthPort)}
+	redirectUri := oAuthUrlParamValueRedirect
+	results := make(chan authorizationResult, 1)
+	report := func(result authorizationResult) {
+		// only the first result is waited for
+		select {
+		case results <- result:
+		default:
+		}
+	}
 
-	http.HandleFunc("/authorization", func(writer http.ResponseWriter, request *http.Request) {
-		js := fmt.Sprintf(`<script type="text/javascript" charset="utf-8">
-				var hash = window.location.search.split("=")[1].split("&")[0];
-				var xmlHttp = new XMLHttpRequest();
-				xmlHttp.open("GET", "http://localhost:%d/authorization/valid?code=" + hash, false);
-				xmlHttp.send(null);
-				xmlHttp.responseText;
-				window.setTimeout('window.location="`+qoveryConsoleUrl+`"; ',2000);
-             </script>`, httpAuthPort)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/authorization", func(writer http.ResponseWriter, request *http.Request) {
+		query := request.URL.Query()
 
-		_, _ = writer.Write([]byte(js))
-		_, _ = writer.Write([]byte("Authentication successful, you'll be redirected to Qovery console. If it's not the case, click on this link: <a href='" + qoveryConsoleUrl + "'>" + qoveryConsoleUrl + "</a>"))
-	})
+		if subtle.ConstantTimeCompare([]byte(query.Get("state")), []byte(state)) != 1 {
+			// not the response to our authorization request, e.g. a CSRF attempt
+			http.Error(writer, "Invalid authentication state, please retry 'qovery auth'.", http.StatusBadRequest)
+			report(authorizationResult{err: errors.New("Invalid authentication state, the response does not match this login attempt. Please, retry 'qovery auth'. ")})
+			return
+		}
 
-	http.HandleFunc("/authorization/valid", func(writer http.ResponseWriter, request *http.Request) {
-		code := request.URL.Query()["code"][0]
-		res, err := http.PostForm(oAuthTokenEndpoint, url.Values{
-			"grant_type":    {"authorization_code"},
-			"client_id":     {oAuthUrlParamValueClient},
-			"code":          {code},
-			"redirect_uri":  {oAuthUrlParamValueRedirect},
-			"code_verifier": {verifier},
-		})
+		if query.Get("error") != "" {
+			http.Error(writer, "Authentication unsuccessful: "+query.Get("error_description"), http.StatusUnauthorized)
+			report(authorizationResult{err: fmt.Errorf("Authentication unsuccessful: %s. ", query.Get("error_description"))})
+			return
+		}
 
+		tokens, err := exchangeAuthorizationCode(query.Get("code"), verifier, redirectUri)
 		if err != nil {
-			utils.PrintlnError(errors.New("Authentication unsuccessful. Try again later or contact #support on 'https://discord.qovery.com'. "))
-			os.Exit(0)
-		} else {
-			defer res.Body.Close()
-			tokens := TokensResponse{}
-			err := json.NewDecoder(res.Body).Decode(&tokens)
-			if err != nil {
-				utils.PrintlnError(errors.New("Authentication unsuccessful. Try again later or contact #support on 'https://discord.qovery.com'. "))
-				os.Exit(0)
-			}
-			expiredAt := tokenExpiration()
-			_ = utils.SetAccessToken(utils.AccessToken(tokens.AccessToken), expiredAt)
-			_ = utils.SetRefreshToken(utils.RefreshToken(tokens.RefreshToken))
-			utils.PrintlnInfo("Success!")
+			http.Error(writer, "Authentication unsuccessful, please retry 'qovery auth'.", http.StatusInternalServerError)
+			report(authorizationResult{err: err})
+			return
 		}
 
-		go func() {
-			time.Sleep(time.Second)
-			if err := srv.Shutdown(context.TODO()); err != nil {
-				utils.PrintlnError(err)
-			}
-		}()
+		writer.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = writer.Write([]byte("Authentication successful, you can close this window and go back to your terminal or open the Qovery console: <a href='" + qoveryConsoleUrl + "'>" + qoveryConsoleUrl + "</a>"))
+		report(authorizationResult{tokens: tokens})
 	})
 
-	_ = srv.ListenAndServe()
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		err := srv.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			report(authorizationResult{err: fmt.Errorf("local authentication server failed: %s", err)})
+		}
+	}()
+	defer func() {
+		// let the browser receive the response before shutting down
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_ = srv.Shutdown(ctx)
+	}()
+
+	authorizationUrl := fmt.Sprintf(oAuthQoveryUrl, url.QueryEscape(oAuthUrlParamValueScopes), utils.OAuthClientId, url.QueryEscape(oAuthUrlParamValueResponseType),
+		url.QueryEscape(oAuthUrlParamValueAudience), url.QueryEscape(redirectUri), challenge, url.QueryEscape(state))
+
+	err = browser.OpenURL(authorizationUrl)
+	if err != nil {
+		return errBrowserUnavailable
+	}
+
+	fmt.Println("\nOpening your browser, waiting for your authentication... ")
+	fmt.Println("If your browser did not open, visit: " + authorizationUrl)
+
+	select {
+	case result := <-results:
+		if result.err != nil {
+			return result.err
+		}
+
+		expiredAt := utils.TokenExpiration(result.tokens.AccessToken, result.tokens.ExpiresIn)
+		return utils.SetTokens(utils.AccessToken(result.tokens.AccessToken), expiredAt, utils.RefreshToken(result.tokens.RefreshToken))
+	case <-time.After(browserAuthTimeout):
+		return fmt.Errorf("Authentication timed out after %s, please retry or use 'qovery auth --headless'. ", browserAuthTimeout)
+	}
 }
 
-func createCodeVerifier() string {
-	length := 64
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+func exchangeAuthorizationCode(code string, verifier string, redirectUri string) (utils.TokensResponse, error) {
+	tokens := utils.TokensResponse{}
+	unsuccessful := errors.New("Authentication unsuccessful. Try again later or contact #support on 'https://discord.qovery.com'. ")
+
+	res, err := http.PostForm(utils.OAuthTokenEndpoint, url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {utils.OAuthClientId},
+		"code":          {code},
+		"redirect_uri":  {redirectUri},
+		"code_verifier": {verifier},
+	})
+	if err != nil {
+		return tokens, unsuccessful
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return tokens, unsuccessful
+	}
+
+	err = json.NewDecoder(res.Body).Decode(&tokens)
+	if err != nil || tokens.AccessToken == "" {
+		return tokens, unsuccessful
+	}
+
+	return tokens, nil
+}
+
+func createCodeVerifier() (string, error) {
+	return randomUrlSafeString(64)
+}
+
+// randomUrlSafeString encodes length bytes from the cryptographic random generator
+func randomUrlSafeString(length int) (string, error) {
 	b := make([]byte, length)
-	for i := 0; i < length; i++ {
-		b[i] = byte(r.Intn(255))
+	if _, err := io.ReadFull(cryptorand.Reader, b); err != nil {
+		return "", err
 	}
-	return encode(b)
+
+	return encode(b), nil
 }
 
 func createCodeChallengeS256(verifier string) (string, error) {
@@ -162,8 +268,12 @@ func encode(msg []byte) string {
 	return encoded
 }
 
-func runHeadlessFlow() {
-	parameters := deviceFlowParameters()
+func runHeadlessFlow() error {
+	parameters, err := deviceFlowParameters()
+	if err != nil {
+		return err
+	}
+
 	requestDeviceActivationWith(parameters)
 	start := time.Now()
 
@@ -174,39 +284,33 @@ func runHeadlessFlow() {
 		tokens, err := getTokensWith(parameters)
 
 		if err == nil {
-			expiredAt := tokenExpiration()
-			_ = utils.SetRefreshToken(utils.RefreshToken(tokens.RefreshToken))
-			_ = utils.SetAccessToken(utils.AccessToken(tokens.AccessToken), expiredAt)
-			utils.PrintlnInfo("Success!")
-			return
+			expiredAt := utils.TokenExpiration(tokens.AccessToken, tokens.ExpiresIn)
+			err = utils.SetTokens(utils.AccessToken(tokens.AccessToken), expiredAt, utils.RefreshToken(tokens.RefreshToken))
+			if err != nil {
+				return err
+			}
+			printSignedIn()
+			return nil
 		}
 	}
 
-	fmt.Println("Code has expired! ")
-	os.Exit(0)
-}
-
-func tokenExpiration() time.Time {
-	oneDay := time.Second * time.Duration(60)
-	return time.Now().Local().Add(oneDay)
+	return &utils.AuthError{Err: errors.New("Code has expired! ")}
 }
 
-func deviceFlowParameters() DeviceFlowParameters {
+func deviceFlowParameters() (DeviceFlowParameters, error) {
 	endpoint := "https://auth.qovery.com/oauth/device/code"
-	payload := strings.NewReader(fmt.Sprintf("client_id=%s&scope=%s&audience=%s&redirect_uri=%s", url.QueryEscape(oAuthUrlParamValueHeadlessClient), url.QueryEscape(oAuthUrlParamValueScopes), url.QueryEscape(oAuthUrlParamValueAudience), url.QueryEscape(oAuthUrlParamValueRedirect)))
+	payload := strings.NewReader(fmt.Sprintf("client_id=%s&scope=%s&audience=%s&redirect_uri=%s", url.QueryEscape(utils.OAuthHeadlessClientId), url.QueryEscape(oAuthUrlParamValueScopes), url.QueryEscape(oAuthUrlParamValueAudience), url.QueryEscape(oAuthUrlParamValueRedirect)))
 	req, err := http.NewRequest("POST", endpoint, payload)
 
 	if err != nil {
-		printContactSupportMessage("Error forming device code request. ")
-		os.Exit(0)
+		return DeviceFlowParameters{}, contactSupportError("Error forming device code request. ")
 	}
 
 	req.Header.Add("content-type", "application/x-www-form-urlencoded")
 	res, err := http.DefaultClient.Do(req)
 
 	if err != nil {
-		printContactSupportMessage("Error getting device code. ")
-		os.Exit(0)
+		return DeviceFlowParameters{}, contactSupportError("Error getting device code. ")
 	}
 
 	if res.StatusCode == 200 {
@@ -216,53 +320,47 @@ func deviceFlowParameters() DeviceFlowParameters {
 		err = json.NewDecoder(res.Body).Decode(&parameters)
 
 		if err != nil {
-			printContactSupportMessage("Error parsing device code response. ")
-			os.Exit(0)
+			return DeviceFlowParameters{}, contactSupportError("Error parsing device code response. ")
 		}
 
-		return parameters
+		return parameters, nil
 	} else {
-		printContactSupportMessage("Error getting device code. ")
-		os.Exit(0)
-		return DeviceFlowParameters{}
+		return DeviceFlowParameters{}, contactSupportError("Error getting device code. ")
 	}
 }
 
-func printContactSupportMessage(msg string) {
-	fmt.Println(msg)
-	fmt.Println("Please contact the #support at 'https://discord.qovery.com'. ")
+func contactSupportError(msg string) error {
+	return errors.New(msg + "Please contact the #support at 'https://discord.qovery.com'. ")
 }
 
 func requestDeviceActivationWith(params DeviceFlowParameters) {
 	fmt.Println("Please, open browser @ " + params.VerificationUri + " using any device and enter " + params.UserCode + " code. ")
 }
 
-func getTokensWith(params DeviceFlowParameters) (TokensResponse, error) {
+func getTokensWith(params DeviceFlowParameters) (utils.TokensResponse, error) {
 	endpoint := "https://auth.qovery.com/oauth/token"
-	payload := strings.NewReader("grant_type=urn%3Aietf%3Aparams%3Aoauth%3Agrant-type%3Adevice_code&device_code=" + params.DeviceCode + "&client_id=" + oAuthUrlParamValueHeadlessClient)
+	payload := strings.NewReader("grant_type=urn%3Aietf%3Aparams%3Aoauth%3Agrant-type%3Adevice_code&device_code=" + params.DeviceCode + "&client_id=" + utils.OAuthHeadlessClientId)
 	req, err := http.NewRequest("POST", endpoint, payload)
 
 	if err != nil {
-		printContactSupportMessage("Error forming get access token request. ")
-		os.Exit(0)
+		return utils.TokensResponse{}, contactSupportError("Error forming get access token request. ")
 	}
 
 	req.Header.Add("content-type", "application/x-www-form-urlencoded")
 	res, err := http.DefaultClient.Do(req)
 
 	if err != nil {
-		printContactSupportMessage("Error pooling access token. ")
-		os.Exit(0)
+		return utils.TokensResponse{}, contactSupportError("Error pooling access token. ")
 	}
 
 	defer res.Body.Close()
 
 	if res.StatusCode == 200 {
-		tokens := TokensResponse{}
+		tokens := utils.TokensResponse{}
 		err = json.NewDecoder(res.Body).Decode(&tokens)
 		return tokens, err
 	} else {
-		return TokensResponse{}, errors.New("Could not fetch tokens")
+		return utils.TokensResponse{}, errors.New("Could not fetch tokens")
 	}
 }
 
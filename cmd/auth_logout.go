@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var authLogoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Revoke the refresh token and wipe the credentials of the current profile",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		err := utils.RevokeRefreshToken()
+		if err != nil {
+			// the local credentials are wiped anyway
+			utils.PrintlnError(err)
+		}
+
+		err = utils.ClearTokens()
+		if err != nil {
+			return err
+		}
+
+		if os.Getenv("QOVERY_CLI_ACCESS_TOKEN") != "" || os.Getenv("Q_CLI_ACCESS_TOKEN") != "" {
+			utils.PrintlnInfo("An access token is still set in the environment, unset QOVERY_CLI_ACCESS_TOKEN to stop using it")
+		}
+
+		profiles, err := utils.CurrentProfiles()
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("Logged out of profile %s", utils.ActiveProfileName(profiles)))
+
+		return nil
+	},
+}
+
+func init() {
+	authCmd.AddCommand(authLogoutCmd)
+}
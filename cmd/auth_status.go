@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/qovery/qovery-client-go"
+	"github.com/spf13/cobra"
+)
+
+var authStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Print the user signed in and the token in use",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+		return printAuthStatus()
+	},
+}
+
+var whoamiCmd = &cobra.Command{
+	Use:   "whoami",
+	Short: "Print the user signed in and the token in use",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+		return printAuthStatus()
+	},
+}
+
+func printAuthStatus() error {
+	// the access token is refreshed when it has expired, the status is read afterwards to show the token in use.
+	// The account endpoint also validates the token against the API.
+	var account *qovery.AccountInfo
+	tokenType, token, tokenErr := utils.GetAccessToken()
+	accountErr := tokenErr
+	if tokenErr == nil {
+		client := utils.GetQoveryClient(tokenType, token)
+		account, _, accountErr = client.AccountInfoApi.GetAccountInformation(context.Background()).Execute()
+	}
+
+	status, err := utils.CurrentAuthStatus()
+	if err != nil {
+		return err
+	}
+
+	data := pterm.TableData{
+		{"Source", status.Source},
+		{"Token type", string(status.TokenType)},
+	}
+
+	if status.User != "" {
+		data = append(data, []string{"User", status.User})
+	}
+
+	if status.Email != "" {
+		data = append(data, []string{"Email", status.Email})
+	}
+
+	if !status.Expiration.IsZero() {
+		data = append(data, []string{"Expires", formatExpiration(status.Expiration)})
+		data = append(data, []string{"Refresh token", fmt.Sprintf("%t", status.HasRefreshToken)})
+		data = append(data, []string{"Token storage", utils.TokenStoreDescription()})
+	}
+
+	switch {
+	case accountErr == nil:
+		name := strings.TrimSpace(account.GetFirstName() + " " + account.GetLastName())
+		if name != "" {
+			data = append(data, []string{"Name", name})
+		}
+		if account.GetNickname() != "" {
+			data = append(data, []string{"Nickname", account.GetNickname()})
+		}
+	case tokenErr != nil || !status.Expiration.IsZero():
+		data = append(data, []string{"Account", fmt.Sprintf("unavailable: %s", accountErr)})
+	}
+
+	return pterm.DefaultTable.WithData(data).Render()
+}
+
+func formatExpiration(expiration time.Time) string {
+	remaining := time.Until(expiration).Round(time.Second)
+	if remaining <= 0 {
+		return fmt.Sprintf("%s (expired, refreshed on next use)", expiration.Local().Format(time.RFC1123))
+	}
+
+	return fmt.Sprintf("%s (in %s)", expiration.Local().Format(time.RFC1123), remaining)
+}
+
+func init() {
+	authCmd.AddCommand(authStatusCmd)
+	rootCmd.AddCommand(whoamiCmd)
+}
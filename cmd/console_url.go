@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"github.com/qovery/qovery-cli/utils"
+)
+
+// consoleUrl is set by the --url flag of the commands accepting a console URL in place of names
+var consoleUrl string
+
+// applyConsoleUrl makes the resources of --url the context of the command, and fills the empty name flags with their
+// IDs, the service one matching the service type, so that lookups by name find exactly these resources
+func applyConsoleUrl() error {
+	if consoleUrl == "" {
+		return nil
+	}
+
+	ctx, err := utils.ResolveConsoleUrl(consoleUrl)
+	if err != nil {
+		return err
+	}
+
+	utils.SetEffectiveContext(ctx)
+
+	setIfEmpty(&organizationName, string(ctx.OrganizationId))
+	setIfEmpty(&projectName, string(ctx.ProjectId))
+	setIfEmpty(&environmentName, string(ctx.EnvironmentId))
+
+	// names may be shared by several services, the ID is not
+	name := string(ctx.ServiceId)
+	switch ctx.ServiceType {
+	case utils.ApplicationType:
+		if applicationName == "" && applicationNames == "" {
+			applicationName = name
+		}
+	case utils.ContainerType:
+		if containerName == "" && containerNames == "" {
+			containerName = name
+		}
+	case utils.DatabaseType:
+		if databaseName == "" {
+			databaseName = name
+		}
+	case utils.JobType:
+		// cronjobs and lifecycle jobs share the job type, the command only reads its own flag
+		if cronjobName == "" && lifecycleName == "" {
+			cronjobName = name
+			lifecycleName = name
+		}
+	}
+
+	return nil
+}
+
+func setIfEmpty(flag *string, value string) {
+	if *flag == "" {
+		*flag = value
+	}
+}
@@ -6,71 +6,61 @@ import (
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
-	"os"
 )
 
 var containerCancelCmd = &cobra.Command{
 	Use:   "cancel",
 	Short: "Cancel a container deployment",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		containers, _, err := client.ContainersApi.ListContainer(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		container := utils.FindByContainerName(containers.GetResults(), containerName)
 
 		if container == nil {
-			utils.PrintlnError(fmt.Errorf("container %s not found", containerName))
-			utils.PrintlnInfo("You can list all containers with: qovery container list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "container", Name: containerName, Candidates: utils.ContainerNames(containers.GetResults())}, "You can list all containers with: qovery container list")
 		}
 
 		msg, err := utils.CancelServiceDeployment(client, envId, container.Id, utils.ContainerType, watchFlag)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if msg != "" {
 			utils.PrintlnInfo(msg)
-			return
+			return nil
 		}
 
 		utils.Println(fmt.Sprintf("Container %s deployment cancelled!", pterm.FgBlue.Sprintf(containerName)))
+
+		return nil
 	},
 }
 
 func init() {
 	containerCmd.AddCommand(containerCancelCmd)
-	containerCancelCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	containerCancelCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	containerCancelCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	containerCancelCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name")
+	containerCancelCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	containerCancelCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	containerCancelCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	containerCancelCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name or ID")
 	containerCancelCmd.Flags().BoolVarP(&watchFlag, "watch", "w", false, "Watch cancel until it's done or an error occurs")
 
 	_ = containerCancelCmd.MarkFlagRequired("container")
@@ -4,8 +4,6 @@ import (
 	"context"
 	"fmt"
 	"github.com/pterm/pterm"
-	"io"
-	"os"
 
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/qovery/qovery-client-go"
@@ -15,56 +13,43 @@ import (
 var containerCloneCmd = &cobra.Command{
 	Use:   "clone",
 	Short: "Clone a container",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		containers, _, err := client.ContainersApi.ListContainer(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		container := utils.FindByContainerName(containers.GetResults(), containerName)
 
 		if container == nil {
-			utils.PrintlnError(fmt.Errorf("container %s not found", containerName))
-			utils.PrintlnInfo("You can list all containers with: qovery container list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "container", Name: containerName, Candidates: utils.ContainerNames(containers.GetResults())}, "You can list all containers with: qovery container list")
 		}
 
 		sourceEnvironment, _, err := client.EnvironmentMainCallsApi.GetEnvironment(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		environments, _, err := client.EnvironmentsApi.ListEnvironment(context.Background(), sourceEnvironment.Project.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if targetEnvironmentName == "" {
@@ -75,9 +60,7 @@ var containerCloneCmd = &cobra.Command{
 		targetEnvironment := utils.FindByEnvironmentName(environments.GetResults(), targetEnvironmentName)
 
 		if targetEnvironment == nil {
-			utils.PrintlnError(fmt.Errorf("environment %s not found", targetEnvironmentName))
-			utils.PrintlnInfo("You can list all environments with: qovery environment list")
-			os.Exit(1)
+			return utils.WithHint(&utils.NotFoundError{Level: "environment", Name: targetEnvironmentName, Candidates: utils.EnvironmentNames(environments.GetResults())}, "You can list all environments with: qovery environment list")
 		}
 
 		var storage []qovery.ServiceStorageRequestStorageInner
@@ -124,58 +107,49 @@ var containerCloneCmd = &cobra.Command{
 			AutoPreview:         &container.AutoPreview,
 		}
 
-		createdService, res, err := client.ContainersApi.CreateContainer(context.Background(), targetEnvironment.Id).ContainerRequest(req).Execute()
+		createdService, _, err := client.ContainersApi.CreateContainer(context.Background(), targetEnvironment.Id).ContainerRequest(req).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-
-			bodyBytes, err := io.ReadAll(res.Body)
-			if err != nil {
-				return
-			}
-
-			utils.PrintlnError(fmt.Errorf("unable to clone container %s", string(bodyBytes)))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			// the body of the response, telling why the clone was rejected, is printed along the error
+			return err
 		}
 
-		deploymentStageId := utils.GetDeploymentStageId(client, container.Id)
+		deploymentStageId, err := utils.GetDeploymentStageId(client, container.Id)
+		if err != nil {
+			return err
+		}
 
 		_, _, err = client.DeploymentStageMainCallsApi.AttachServiceToDeploymentStage(context.Background(), deploymentStageId, createdService.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		// clone advanced settings
 		settings, _, err := client.ContainerConfigurationApi.GetContainerAdvancedSettings(context.Background(), container.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		_, _, err = client.ContainerConfigurationApi.EditContainerAdvancedSettings(context.Background(), createdService.Id).ContainerAdvancedSettings(*settings).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Container %s cloned!", pterm.FgBlue.Sprintf(containerName)))
+
+		return nil
 	},
 }
 
 func init() {
 	containerCmd.AddCommand(containerCloneCmd)
-	containerCloneCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	containerCloneCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	containerCloneCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	containerCloneCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name")
+	containerCloneCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	containerCloneCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	containerCloneCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	containerCloneCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name or ID")
 	containerCloneCmd.Flags().StringVarP(&targetEnvironmentName, "target-environment", "", "", "Target Environment Name")
 	containerCloneCmd.Flags().StringVarP(&targetContainerName, "target-container-name", "", "", "Target Container Name")
 
@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
@@ -14,42 +13,32 @@ import (
 var containerDeployCmd = &cobra.Command{
 	Use:   "deploy",
 	Short: "Deploy a container",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if containerName == "" && containerNames == "" {
-			utils.PrintlnError(fmt.Errorf("use neither --cronjob \"<container name>\" nor --cronjobs \"<container1 name>, <container2 name>\""))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("use neither --cronjob \"<container name>\" nor --cronjobs \"<container1 name>, <container2 name>\"")
 		}
 
 		if containerName != "" && containerNames != "" {
-			utils.PrintlnError(fmt.Errorf("you can't use --container and --containers at the same time"))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("you can't use --container and --containers at the same time")
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if !utils.IsEnvironmentInATerminalState(envId, client) {
-			utils.PrintlnError(fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
-				"for the end of the current operation to run your command. Try again in a few moment", envId))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
+				"for the end of the current operation to run your command. Try again in a few moment", envId)
 		}
 
 		if containerNames != "" {
@@ -57,35 +46,28 @@ var containerDeployCmd = &cobra.Command{
 			err := utils.DeployContainers(client, envId, containerNames, containerTag)
 
 			if err != nil {
-				utils.PrintlnError(err)
-				os.Exit(1)
-				panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+				return err
 			}
 
 			utils.Println(fmt.Sprintf("Deploying containers %s in progress..", pterm.FgBlue.Sprintf(containerNames)))
 
 			if watchFlag {
-				utils.WatchEnvironment(envId, "unused", client)
+				return utils.WatchEnvironment(envId, "unused", client)
 			}
 
-			return
+			return nil
 		}
 
 		containers, _, err := client.ContainersApi.ListContainer(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		container := utils.FindByContainerName(containers.GetResults(), containerName)
 
 		if container == nil {
-			utils.PrintlnError(fmt.Errorf("container %s not found", containerName))
-			utils.PrintlnInfo("You can list all containers with: qovery container list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "container", Name: containerName, Candidates: utils.ContainerNames(containers.GetResults())}, "You can list all containers with: qovery container list")
 		}
 
 		req := qovery.ContainerDeployRequest{
@@ -99,25 +81,26 @@ var containerDeployCmd = &cobra.Command{
 		_, _, err = client.ContainerActionsApi.DeployContainer(context.Background(), container.Id).ContainerDeployRequest(req).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Deploying container %s in progress..", pterm.FgBlue.Sprintf(containerName)))
 
 		if watchFlag {
-			utils.WatchContainer(container.Id, envId, client)
+			return utils.WatchContainer(container.Id, envId, client)
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	containerCmd.AddCommand(containerDeployCmd)
-	containerDeployCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	containerDeployCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	containerDeployCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	containerDeployCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name")
+	containerDeployCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	containerDeployCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	containerDeployCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	containerDeployCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name or ID")
+	containerDeployCmd.Flags().StringVarP(&consoleUrl, "url", "", "", "Qovery console URL of the resource, instead of its names")
 	containerDeployCmd.Flags().StringVarP(&containerNames, "containers", "", "", "Container Names (comma separated) (ex: --containers \"container1,container2\")")
 	containerDeployCmd.Flags().StringVarP(&containerTag, "tag", "t", "", "Container Tag")
 	containerDeployCmd.Flags().BoolVarP(&watchFlag, "watch", "w", false, "Watch container status until it's ready or an error occurs")
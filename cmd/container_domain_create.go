@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"github.com/qovery/qovery-client-go"
-	"os"
 
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
@@ -14,55 +13,42 @@ import (
 var containerDomainCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create container custom domain",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		containers, _, err := client.ContainersApi.ListContainer(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		container := utils.FindByContainerName(containers.GetResults(), applicationName)
 
 		if container == nil {
-			utils.PrintlnError(fmt.Errorf("container %s not found", applicationName))
-			utils.PrintlnInfo("You can list all containers with: qovery container list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "container", Name: applicationName, Candidates: utils.ContainerNames(containers.GetResults())}, "You can list all containers with: qovery container list")
 		}
 
 		customDomains, _, err := client.ContainerCustomDomainApi.ListContainerCustomDomain(context.Background(), container.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		customDomain := utils.FindByCustomDomainName(customDomains.GetResults(), applicationCustomDomain)
 		if customDomain != nil {
-			utils.PrintlnError(fmt.Errorf("custom domain %s already exists", applicationCustomDomain))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("custom domain %s already exists", applicationCustomDomain)
 		}
 
 		req := qovery.CustomDomainRequest{
@@ -72,21 +58,21 @@ var containerDomainCreateCmd = &cobra.Command{
 		_, _, err = client.ContainerCustomDomainApi.CreateContainerCustomDomain(context.Background(), container.Id).CustomDomainRequest(req).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Custom domain %s has been created", pterm.FgBlue.Sprintf(applicationCustomDomain)))
+
+		return nil
 	},
 }
 
 func init() {
 	containerDomainCmd.AddCommand(containerDomainCreateCmd)
-	containerDomainCreateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	containerDomainCreateCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	containerDomainCreateCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	containerDomainCreateCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name")
+	containerDomainCreateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	containerDomainCreateCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	containerDomainCreateCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	containerDomainCreateCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name or ID")
 	containerDomainCreateCmd.Flags().StringVarP(&containerCustomDomain, "domain", "", "", "Custom Domain <subdomain.domain.tld>")
 
 	_ = containerDomainCreateCmd.MarkFlagRequired("container")
@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
@@ -13,75 +12,62 @@ import (
 var containerDomainDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete container custom domain",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		containers, _, err := client.ContainersApi.ListContainer(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		container := utils.FindByContainerName(containers.GetResults(), containerName)
 
 		if container == nil {
-			utils.PrintlnError(fmt.Errorf("container %s not found", containerName))
-			utils.PrintlnInfo("You can list all containers with: qovery container list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "container", Name: containerName, Candidates: utils.ContainerNames(containers.GetResults())}, "You can list all containers with: qovery container list")
 		}
 
 		customDomains, _, err := client.ContainerCustomDomainApi.ListContainerCustomDomain(context.Background(), container.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		customDomain := utils.FindByCustomDomainName(customDomains.GetResults(), containerCustomDomain)
 		if customDomain == nil {
-			utils.PrintlnError(fmt.Errorf("custom domain %s does not exist", containerCustomDomain))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return &utils.NotFoundError{Level: "custom domain", Name: containerCustomDomain, Candidates: utils.CustomDomainNames(customDomains.GetResults())}
 		}
 
 		_, err = client.ContainerCustomDomainApi.DeleteContainerCustomDomain(context.Background(), container.Id, customDomain.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Custom domain %s has been deleted", pterm.FgBlue.Sprintf(containerCustomDomain)))
+
+		return nil
 	},
 }
 
 func init() {
 	containerDomainCmd.AddCommand(containerDomainDeleteCmd)
-	containerDomainDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	containerDomainDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	containerDomainDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	containerDomainDeleteCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name")
+	containerDomainDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	containerDomainDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	containerDomainDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	containerDomainDeleteCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name or ID")
 	containerDomainDeleteCmd.Flags().StringVarP(&containerCustomDomain, "domain", "", "", "Custom Domain <subdomain.domain.tld>")
 
 	_ = containerDomainDeleteCmd.MarkFlagRequired("container")
@@ -2,8 +2,6 @@ package cmd
 
 import (
 	"context"
-	"fmt"
-	"os"
 	"strings"
 
 	"github.com/qovery/qovery-cli/utils"
@@ -13,14 +11,12 @@ import (
 var containerDomainListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List container domains",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
@@ -28,34 +24,25 @@ var containerDomainListCmd = &cobra.Command{
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		containers, _, err := client.ContainersApi.ListContainer(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		container := utils.FindByContainerName(containers.GetResults(), containerName)
 
 		if container == nil {
-			utils.PrintlnError(fmt.Errorf("container %s not found", containerName))
-			utils.PrintlnInfo("You can list all containers with: qovery container list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "container", Name: containerName, Candidates: utils.ContainerNames(containers.GetResults())}, "You can list all containers with: qovery container list")
 		}
 
 		customDomains, _, err := client.ContainerCustomDomainApi.ListContainerCustomDomain(context.Background(), container.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		customDomainsSet := make(map[string]bool)
@@ -74,9 +61,7 @@ var containerDomainListCmd = &cobra.Command{
 		links, _, err := client.ContainerMainCallsApi.ListContainerLinks(context.Background(), container.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		for _, link := range links.GetResults() {
@@ -95,19 +80,19 @@ var containerDomainListCmd = &cobra.Command{
 		err = utils.PrintTable([]string{"Type", "Domain", "Validation Domain"}, data)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	containerDomainCmd.AddCommand(containerDomainListCmd)
-	containerDomainListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	containerDomainListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	containerDomainListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	containerDomainListCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name")
+	containerDomainListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	containerDomainListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	containerDomainListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	containerDomainListCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name or ID")
 
 	_ = containerDomainListCmd.MarkFlagRequired("container")
 }
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var containerEnvAliasDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete container environment variable or secret alias",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		_, projectId, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		containers, _, err := client.ContainersApi.ListContainer(context.Background(), envId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		container := utils.FindByContainerName(containers.GetResults(), containerName)
+
+		if container == nil {
+			return utils.WithHint(&utils.NotFoundError{Level: "container", Name: containerName, Candidates: utils.ContainerNames(containers.GetResults())}, "You can list all containers with: qovery container list")
+		}
+
+		err = utils.DeleteAliasByKey(client, projectId, envId, container.Id, utils.ContainerType, utils.Alias)
+
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("Alias %s has been deleted", pterm.FgBlue.Sprintf(utils.Alias)))
+
+		return nil
+	},
+}
+
+func init() {
+	containerEnvAliasCmd.AddCommand(containerEnvAliasDeleteCmd)
+	containerEnvAliasDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	containerEnvAliasDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	containerEnvAliasDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	containerEnvAliasDeleteCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name or ID")
+	containerEnvAliasDeleteCmd.Flags().StringVarP(&utils.Alias, "alias", "", "", "Environment variable or secret alias")
+
+	_ = containerEnvAliasDeleteCmd.MarkFlagRequired("alias")
+	_ = containerEnvAliasDeleteCmd.MarkFlagRequired("container")
+}
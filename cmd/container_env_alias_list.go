@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var containerEnvAliasListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List container environment variable and secret aliases",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+
+		_, _, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		containers, _, err := client.ContainersApi.ListContainer(context.Background(), envId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		container := utils.FindByContainerName(containers.GetResults(), containerName)
+
+		if container == nil {
+			return utils.WithHint(&utils.NotFoundError{Level: "container", Name: containerName, Candidates: utils.ContainerNames(containers.GetResults())}, "You can list all containers with: qovery container list")
+		}
+
+		envVars, _, err := client.ContainerEnvironmentVariableApi.ListContainerEnvironmentVariable(
+			context.Background(),
+			container.Id,
+		).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		secrets, _, err := client.ContainerSecretApi.ListContainerSecrets(
+			context.Background(),
+			container.Id,
+		).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		envVarLines := utils.NewEnvVarLines()
+
+		for _, envVar := range envVars.GetResults() {
+			if envVar.AliasedVariable != nil {
+				envVarLines.Add(utils.FromEnvironmentVariableToEnvVarLineOutput(envVar))
+			}
+		}
+
+		for _, secret := range secrets.GetResults() {
+			if secret.AliasedSecret != nil {
+				envVarLines.Add(utils.FromSecretToEnvVarLineOutput(secret))
+			}
+		}
+
+		err = utils.PrintTable(envVarLines.Header(false), envVarLines.Lines(utils.ShowValues, false))
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	containerEnvAliasCmd.AddCommand(containerEnvAliasListCmd)
+	containerEnvAliasListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	containerEnvAliasListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	containerEnvAliasListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	containerEnvAliasListCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name or ID")
+	containerEnvAliasListCmd.Flags().BoolVarP(&utils.ShowValues, "show-values", "", false, "Show env var values")
+
+	_ = containerEnvAliasListCmd.MarkFlagRequired("container")
+}
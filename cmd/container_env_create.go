@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
@@ -13,73 +12,62 @@ import (
 var containerEnvCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create container environment variable or secret",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, projectId, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		containers, _, err := client.ContainersApi.ListContainer(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		container := utils.FindByContainerName(containers.GetResults(), containerName)
 
 		if container == nil {
-			utils.PrintlnError(fmt.Errorf("container %s not found", containerName))
-			utils.PrintlnInfo("You can list all containers with: qovery container list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "container", Name: containerName, Candidates: utils.ContainerNames(containers.GetResults())}, "You can list all containers with: qovery container list")
 		}
 
 		if utils.IsSecret {
 			err = utils.CreateSecret(client, projectId, envId, container.Id, utils.Key, utils.Value, utils.ContainerScope)
 
 			if err != nil {
-				utils.PrintlnError(err)
-				os.Exit(1)
-				panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+				return err
 			}
 
 			utils.Println(fmt.Sprintf("Secret %s has been created", pterm.FgBlue.Sprintf(utils.Key)))
-			return
+			return nil
 		}
 
 		err = utils.CreateEnvironmentVariable(client, projectId, envId, container.Id, utils.Key, utils.Value, utils.ContainerScope)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Environment variable %s has been created", pterm.FgBlue.Sprintf(utils.Key)))
+
+		return nil
 	},
 }
 
 func init() {
 	containerEnvCmd.AddCommand(containerEnvCreateCmd)
-	containerEnvCreateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	containerEnvCreateCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	containerEnvCreateCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	containerEnvCreateCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name")
+	containerEnvCreateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	containerEnvCreateCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	containerEnvCreateCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	containerEnvCreateCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name or ID")
 	containerEnvCreateCmd.Flags().StringVarP(&utils.Key, "key", "k", "", "Environment variable or secret key")
 	containerEnvCreateCmd.Flags().StringVarP(&utils.Value, "value", "v", "", "Environment variable or secret value")
 	containerEnvCreateCmd.Flags().StringVarP(&utils.ContainerScope, "scope", "", "CONTAINER", "Scope of this env var <PROJECT|ENVIRONMENT|CONTAINER>")
@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
@@ -13,60 +12,51 @@ import (
 var containerEnvDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete container environment variable or secret",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, projectId, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		containers, _, err := client.ContainersApi.ListContainer(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		container := utils.FindByContainerName(containers.GetResults(), containerName)
 
 		if container == nil {
-			utils.PrintlnError(fmt.Errorf("container %s not found", containerName))
-			utils.PrintlnInfo("You can list all containers with: qovery container list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "container", Name: containerName, Candidates: utils.ContainerNames(containers.GetResults())}, "You can list all containers with: qovery container list")
 		}
 
 		err = utils.DeleteByKey(client, projectId, envId, container.Id, utils.ContainerType, utils.Key)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Variable %s has been deleted", pterm.FgBlue.Sprintf(utils.Key)))
+
+		return nil
 	},
 }
 
 func init() {
 	containerEnvCmd.AddCommand(containerEnvDeleteCmd)
-	containerEnvDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	containerEnvDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	containerEnvDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	containerEnvDeleteCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name")
+	containerEnvDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	containerEnvDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	containerEnvDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	containerEnvDeleteCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name or ID")
 	containerEnvDeleteCmd.Flags().StringVarP(&utils.Key, "key", "k", "", "Environment variable or secret key")
 
 	_ = containerEnvDeleteCmd.MarkFlagRequired("key")
@@ -2,8 +2,6 @@ package cmd
 
 import (
 	"context"
-	"fmt"
-	"os"
 
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
@@ -12,14 +10,12 @@ import (
 var containerEnvListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List container environment variables",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
@@ -27,26 +23,19 @@ var containerEnvListCmd = &cobra.Command{
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		containers, _, err := client.ContainersApi.ListContainer(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		container := utils.FindByContainerName(containers.GetResults(), containerName)
 
 		if container == nil {
-			utils.PrintlnError(fmt.Errorf("container %s not found", containerName))
-			utils.PrintlnInfo("You can list all containers with: qovery container list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "container", Name: containerName, Candidates: utils.ContainerNames(containers.GetResults())}, "You can list all containers with: qovery container list")
 		}
 
 		envVars, _, err := client.ContainerEnvironmentVariableApi.ListContainerEnvironmentVariable(
@@ -55,9 +44,7 @@ var containerEnvListCmd = &cobra.Command{
 		).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		secrets, _, err := client.ContainerSecretApi.ListContainerSecrets(
@@ -66,9 +53,7 @@ var containerEnvListCmd = &cobra.Command{
 		).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		envVarLines := utils.NewEnvVarLines()
@@ -84,19 +69,20 @@ var containerEnvListCmd = &cobra.Command{
 		err = utils.PrintTable(envVarLines.Header(utils.PrettyPrint), envVarLines.Lines(utils.ShowValues, utils.PrettyPrint))
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	containerEnvCmd.AddCommand(containerEnvListCmd)
-	containerEnvListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	containerEnvListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	containerEnvListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	containerEnvListCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name")
+	containerEnvListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	containerEnvListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	containerEnvListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	containerEnvListCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name or ID")
+	containerEnvListCmd.Flags().StringVarP(&consoleUrl, "url", "", "", "Qovery console URL of the resource, instead of its names")
 	containerEnvListCmd.Flags().BoolVarP(&utils.ShowValues, "show-values", "", false, "Show env var values")
 	containerEnvListCmd.Flags().BoolVarP(&utils.PrettyPrint, "pretty-print", "", false, "Pretty print output")
 
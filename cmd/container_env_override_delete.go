@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var containerEnvOverrideDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete container environment variable or secret override",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		_, projectId, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		containers, _, err := client.ContainersApi.ListContainer(context.Background(), envId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		container := utils.FindByContainerName(containers.GetResults(), containerName)
+
+		if container == nil {
+			return utils.WithHint(&utils.NotFoundError{Level: "container", Name: containerName, Candidates: utils.ContainerNames(containers.GetResults())}, "You can list all containers with: qovery container list")
+		}
+
+		err = utils.DeleteOverrideByKey(client, projectId, envId, container.Id, utils.ContainerType, utils.Key)
+
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("Override %s has been deleted", pterm.FgBlue.Sprintf(utils.Key)))
+
+		return nil
+	},
+}
+
+func init() {
+	containerEnvOverrideCmd.AddCommand(containerEnvOverrideDeleteCmd)
+	containerEnvOverrideDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	containerEnvOverrideDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	containerEnvOverrideDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	containerEnvOverrideDeleteCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name or ID")
+	containerEnvOverrideDeleteCmd.Flags().StringVarP(&utils.Key, "key", "k", "", "Environment variable or secret key")
+
+	_ = containerEnvOverrideDeleteCmd.MarkFlagRequired("key")
+	_ = containerEnvOverrideDeleteCmd.MarkFlagRequired("container")
+}
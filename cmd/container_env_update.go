@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var containerEnvUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update container environment variable or secret value",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		_, projectId, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		containers, _, err := client.ContainersApi.ListContainer(context.Background(), envId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		container := utils.FindByContainerName(containers.GetResults(), containerName)
+
+		if container == nil {
+			return utils.WithHint(&utils.NotFoundError{Level: "container", Name: containerName, Candidates: utils.ContainerNames(containers.GetResults())}, "You can list all containers with: qovery container list")
+		}
+
+		value, err := utils.ReadValue(utils.Value, utils.ValueFromFile, utils.ValueFromStdin)
+
+		if err != nil {
+			return err
+		}
+
+		err = utils.UpdateByKey(client, projectId, envId, container.Id, utils.ContainerType, utils.Key, value)
+
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("Variable %s has been updated", pterm.FgBlue.Sprintf(utils.Key)))
+
+		return nil
+	},
+}
+
+func init() {
+	containerEnvCmd.AddCommand(containerEnvUpdateCmd)
+	containerEnvUpdateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	containerEnvUpdateCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	containerEnvUpdateCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	containerEnvUpdateCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name or ID")
+	containerEnvUpdateCmd.Flags().StringVarP(&utils.Key, "key", "k", "", "Environment variable or secret key")
+	containerEnvUpdateCmd.Flags().StringVarP(&utils.Value, "value", "v", "", "Environment variable or secret value")
+	containerEnvUpdateCmd.Flags().StringVarP(&utils.ValueFromFile, "value-from-file", "", "", "Read the environment variable or secret value from a file")
+	containerEnvUpdateCmd.Flags().BoolVarP(&utils.ValueFromStdin, "value-from-stdin", "", false, "Read the environment variable or secret value from stdin")
+
+	_ = containerEnvUpdateCmd.MarkFlagRequired("key")
+	_ = containerEnvUpdateCmd.MarkFlagRequired("container")
+}
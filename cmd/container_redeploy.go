@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
@@ -13,71 +12,60 @@ import (
 var containerRedeployCmd = &cobra.Command{
 	Use:   "redeploy",
 	Short: "Redeploy a container",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if !utils.IsEnvironmentInATerminalState(envId, client) {
-			utils.PrintlnError(fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
-				"for the end of the current operation to run your command. Try again in a few moment", envId))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
+				"for the end of the current operation to run your command. Try again in a few moment", envId)
 		}
 
 		containers, _, err := client.ContainersApi.ListContainer(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		container := utils.FindByContainerName(containers.GetResults(), containerName)
 
 		if container == nil {
-			utils.PrintlnError(fmt.Errorf("container %s not found", containerName))
-			utils.PrintlnInfo("You can list all containers with: qovery container list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "container", Name: containerName, Candidates: utils.ContainerNames(containers.GetResults())}, "You can list all containers with: qovery container list")
 		}
 
 		_, _, err = client.ContainerActionsApi.RedeployContainer(context.Background(), container.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Redeploying container %s in progress..", pterm.FgBlue.Sprintf(containerName)))
 
 		if watchFlag {
-			utils.WatchContainer(container.Id, envId, client)
+			return utils.WatchContainer(container.Id, envId, client)
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	containerCmd.AddCommand(containerRedeployCmd)
-	containerRedeployCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	containerRedeployCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	containerRedeployCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	containerRedeployCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name")
+	containerRedeployCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	containerRedeployCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	containerRedeployCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	containerRedeployCmd.Flags().StringVarP(&containerName, "container", "n", "", "Container Name or ID")
 	containerRedeployCmd.Flags().BoolVarP(&watchFlag, "watch", "w", false, "Watch container status until it's ready or an error occurs")
 
 	_ = containerRedeployCmd.MarkFlagRequired("container")
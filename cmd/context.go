@@ -9,15 +9,20 @@ import (
 var contextCmd = &cobra.Command{
 	Use:   "context",
 	Short: "Manage CLI context",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 		utils.PrintlnInfo("Current context:")
+		if path := utils.FindProjectContextFile(); path != "" {
+			utils.PrintlnInfo(fmt.Sprintf("Pinned by %s", path))
+		}
 		err := utils.PrintlnContext()
 		if err != nil {
 			fmt.Println("Context not yet configured. ")
 		}
 		println()
 		utils.PrintlnInfo("You can set a new context using 'qovery context set'. ")
+
+		return nil
 	},
 }
 
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"sort"
+
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var contextListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List context profiles",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		profiles, err := utils.CurrentProfiles()
+		if err != nil {
+			return err
+		}
+
+		activeProfile := utils.ActiveProfileName(profiles)
+
+		var names []string
+		for name := range profiles.Profiles {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var data [][]string
+
+		for _, name := range names {
+			context := profiles.Profiles[name]
+
+			current := ""
+			if name == activeProfile {
+				current = "*"
+			}
+
+			data = append(data, []string{current, name, string(context.User), string(context.OrganizationName),
+				string(context.ProjectName), string(context.EnvironmentName), string(context.ServiceName)})
+		}
+
+		return utils.PrintTable([]string{"Current", "Profile", "User", "Organization", "Project", "Environment", "Service"}, data)
+	},
+}
+
+func init() {
+	contextCmd.AddCommand(contextListCmd)
+}
@@ -7,11 +7,24 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var contextServiceName string
+var contextUrl string
+
 var setCmd = &cobra.Command{
 	Use:   "set",
 	Short: "Set Qovery CLI context",
-	Run: func(cmd *cobra.Command, args []string) {
+	Long: `SET selects the organization, project, environment and service used by default.
+Without flags, the selection is interactive. Flags accept names or IDs, for example:
+
+  qovery context set --organization "My Organization" --project backend --environment staging --service api
+  qovery context set --url https://console.qovery.com/organization/<id>/project/<id>/environment/<id>/application/<id>`,
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
+
+		if organizationName != "" || projectName != "" || environmentName != "" || contextServiceName != "" || contextUrl != "" {
+			return setContextFromFlags()
+		}
+
 		utils.PrintlnInfo("Current context:")
 		err := utils.PrintlnContext()
 		if err != nil {
@@ -22,26 +35,22 @@ var setCmd = &cobra.Command{
 		utils.PrintlnInfo("Select new context")
 		orga, err := utils.SelectAndSetOrganization()
 		if err != nil {
-			utils.PrintlnError(err)
-			return
+			return err
 		}
 
 		project, err := utils.SelectAndSetProject(orga.ID)
 		if err != nil {
-			utils.PrintlnError(err)
-			return
+			return err
 		}
 
 		env, err := utils.SelectAndSetEnvironment(project.ID)
 		if err != nil {
-			utils.PrintlnError(err)
-			return
+			return err
 		}
 
 		_, err = utils.SelectAndSetService(env.ID)
 		if err != nil {
-			utils.PrintlnError(err)
-			return
+			return err
 		}
 		_, _ = utils.CurrentService()
 		println()
@@ -50,9 +59,58 @@ var setCmd = &cobra.Command{
 		if err != nil {
 			utils.PrintlnError(err)
 		}
+
+		return nil
 	},
 }
 
+func setContextFromFlags() error {
+	selection := utils.ContextSelection{
+		Organization: organizationName,
+		Project:      projectName,
+		Environment:  environmentName,
+		Service:      contextServiceName,
+	}
+
+	if contextUrl != "" {
+		fromUrl, err := utils.ParseConsoleUrl(contextUrl)
+		if err != nil {
+			return err
+		}
+
+		selection = *fromUrl
+	}
+
+	ctx, err := utils.CurrentContext()
+	if err != nil {
+		return err
+	}
+
+	ctx, err = utils.ResolveContextSelection(ctx, selection)
+	if err != nil {
+		return err
+	}
+
+	err = utils.StoreContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	utils.PrintlnInfo("New context:")
+	err = utils.PrintlnContext()
+	if err != nil {
+		// partial contexts can't be printed as a table
+		utils.PrintlnInfo(err.Error())
+	}
+
+	return nil
+}
+
 func init() {
 	contextCmd.AddCommand(setCmd)
+	setCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	setCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	setCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	setCmd.Flags().StringVarP(&contextServiceName, "service", "", "", "Service Name or ID")
+	setCmd.Flags().StringVarP(&contextUrl, "url", "", "", "Qovery console URL of an organization, project, environment or service")
 }
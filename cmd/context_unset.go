@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var contextUnsetCmd = &cobra.Command{
+	Use:       "unset <organization|project|environment|service>",
+	Short:     "Unset a level of the Qovery CLI context and the levels below it",
+	Args:      cobra.ExactValidArgs(1),
+	ValidArgs: []string{"organization", "project", "environment", "service"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		err := utils.UnsetContextLevel(args[0])
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("Context %s has been unset", pterm.FgBlue.Sprintf(args[0])))
+
+		return nil
+	},
+}
+
+func init() {
+	contextCmd.AddCommand(contextUnsetCmd)
+}
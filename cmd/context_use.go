@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var contextUseCmd = &cobra.Command{
+	Use:   "use <profile>",
+	Short: "Switch to another context profile",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		profiles, err := utils.CurrentProfiles()
+		if err != nil {
+			return err
+		}
+
+		_, exists := profiles.Profiles[args[0]]
+
+		err = utils.UseProfile(args[0])
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("Switched to profile %s", pterm.FgBlue.Sprintf(args[0])))
+
+		if !exists {
+			utils.PrintlnInfo("This profile is new, sign in using 'qovery auth' and set its context using 'qovery context set'. ")
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	contextCmd.AddCommand(contextUseCmd)
+}
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/qovery/qovery-cli/pkg"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var cpCmd = &cobra.Command{
+	Use:   "cp <source> <destination>",
+	Short: "Copy files and directories to and from a service container",
+	Long: `Copy files and directories to and from a service container. The remote side is written <service>:<path>.
+The copy goes through 'tar' in the container, the checksum of every copied file is verified with 'sha256sum'.
+Copying into an existing directory keeps the name of the source, like cp.`,
+	Example: `  qovery cp ./dump.sql api:/tmp/dump.sql
+  qovery cp ./dump.sql api:/tmp/
+  qovery cp api:/tmp/dump.sql ./dump.sql`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		sourceService, sourcePath := splitRemotePath(args[0])
+		destinationService, destinationPath := splitRemotePath(args[1])
+
+		if (sourceService == "") == (destinationService == "") {
+			return errors.New("exactly one of source and destination must be a <service>:<path>")
+		}
+
+		serviceName = sourceService + destinationService
+		shellRequest, err := shellRequestFromFlags()
+		if err != nil {
+			return err
+		}
+
+		shellRequest.PodName = podName
+		shellRequest.ContainerName = podContainerName
+		// every step of the copy opens its own connection, they must all reach the same instance
+		if shellRequest.PodName == "" {
+			shellRequest.PodName, err = copyInstance(shellRequest)
+			if err != nil {
+				return err
+			}
+		}
+
+		if sourceService != "" {
+			err = pkg.CopyFromContainer(shellRequest, sourcePath, destinationPath, os.Stderr)
+		} else {
+			err = pkg.CopyToContainer(shellRequest, sourcePath, destinationPath, os.Stderr)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("%s copied to %s", args[0], args[1]))
+
+		return nil
+	},
+}
+
+// copyInstance returns the instance to copy from or to, prompted for on a terminal when there are several, the first
+// one otherwise. It is empty for the services whose instances can't be listed, e.g. databases.
+func copyInstance(shellRequest *pkg.ShellRequest) (string, error) {
+	hasInstances := shellRequest.ServiceType == utils.ApplicationType || shellRequest.ServiceType == utils.ContainerType || shellRequest.ServiceType == utils.JobType
+	if !hasInstances {
+		return "", nil
+	}
+
+	if utils.IsInteractive() {
+		return utils.SelectServiceInstance(shellRequest.ServiceID, shellRequest.ServiceType)
+	}
+
+	tokenType, token, err := utils.GetAccessToken()
+	if err != nil {
+		return "", err
+	}
+
+	instances, err := utils.ListServiceInstances(utils.GetQoveryClient(tokenType, token), string(shellRequest.ServiceID), shellRequest.ServiceType)
+	if err != nil {
+		return "", err
+	}
+
+	if len(instances) == 0 {
+		return "", errors.New("no running instance found")
+	}
+
+	return instances[0].GetName(), nil
+}
+
+// splitRemotePath splits <service>:<path>, a local path has no service. Windows drive letters are not services.
+func splitRemotePath(arg string) (string, string) {
+	service, path, found := strings.Cut(arg, ":")
+	if !found || len(service) <= 1 || strings.ContainsAny(service, `/\`) {
+		return "", arg
+	}
+
+	return service, path
+}
+
+func init() {
+	rootCmd.AddCommand(cpCmd)
+	cpCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	cpCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	cpCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	cpCmd.Flags().StringVarP(&podName, "pod", "", "", "Instance (pod) to copy from or to, prompted for when there are several, the first one when not on a terminal")
+	cpCmd.Flags().StringVarP(&podContainerName, "container", "", "", "Container of the pod to copy from or to")
+}
@@ -6,71 +6,61 @@ import (
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
-	"os"
 )
 
 var cronjobCancelCmd = &cobra.Command{
 	Use:   "cancel",
 	Short: "Cancel a cronjob deployment",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		cronjobs, _, err := client.JobsApi.ListJobs(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		cronjob := utils.FindByJobName(cronjobs.GetResults(), cronjobName)
 
 		if cronjob == nil {
-			utils.PrintlnError(fmt.Errorf("cronjob %s not found", cronjobName))
-			utils.PrintlnInfo("You can list all cronjobs with: qovery cronjob list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "cronjob", Name: cronjobName, Candidates: utils.JobNames(cronjobs.GetResults())}, "You can list all cronjobs with: qovery cronjob list")
 		}
 
 		msg, err := utils.CancelServiceDeployment(client, envId, cronjob.Id, utils.JobType, watchFlag)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if msg != "" {
 			utils.PrintlnInfo(msg)
-			return
+			return nil
 		}
 
 		utils.Println(fmt.Sprintf("Cronjob %s deployment cancelled!", pterm.FgBlue.Sprintf(cronjobName)))
+
+		return nil
 	},
 }
 
 func init() {
 	cronjobCmd.AddCommand(cronjobCancelCmd)
-	cronjobCancelCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	cronjobCancelCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	cronjobCancelCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	cronjobCancelCmd.Flags().StringVarP(&cronjobName, "cronjob", "n", "", "Cronjob Name")
+	cronjobCancelCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	cronjobCancelCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	cronjobCancelCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	cronjobCancelCmd.Flags().StringVarP(&cronjobName, "cronjob", "n", "", "Cronjob Name or ID")
 	cronjobCancelCmd.Flags().BoolVarP(&watchFlag, "watch", "w", false, "Watch cancel until it's done or an error occurs")
 
 	_ = cronjobCancelCmd.MarkFlagRequired("cronjob")
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"github.com/pterm/pterm"
-	"os"
 
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
@@ -13,71 +12,60 @@ import (
 var cronjobDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete a cronjob",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if !utils.IsEnvironmentInATerminalState(envId, client) {
-			utils.PrintlnError(fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
-				"for the end of the current operation to run your command. Try again in a few moment", envId))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
+				"for the end of the current operation to run your command. Try again in a few moment", envId)
 		}
 
 		cronjobs, err := ListCronjobs(envId, client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		job := utils.FindByJobName(cronjobs, cronjobName)
 
 		if job == nil {
-			utils.PrintlnError(fmt.Errorf("job %s not found", cronjobName))
-			utils.PrintlnInfo("You can list all cronjobs with: qovery cronjob list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "job", Name: cronjobName, Candidates: utils.JobNames(cronjobs)}, "You can list all cronjobs with: qovery cronjob list")
 		}
 
 		_, err = client.JobMainCallsApi.DeleteJob(context.Background(), job.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Deleting cronjob %s in progress..", pterm.FgBlue.Sprintf(cronjobName)))
 
 		if watchFlag {
-			utils.WatchJob(job.Id, envId, client)
+			return utils.WatchJob(job.Id, envId, client)
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	cronjobCmd.AddCommand(cronjobDeleteCmd)
-	cronjobDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	cronjobDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	cronjobDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	cronjobDeleteCmd.Flags().StringVarP(&cronjobName, "cronjob", "n", "", "Cronjob Name")
+	cronjobDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	cronjobDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	cronjobDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	cronjobDeleteCmd.Flags().StringVarP(&cronjobName, "cronjob", "n", "", "Cronjob Name or ID")
 	cronjobDeleteCmd.Flags().BoolVarP(&watchFlag, "watch", "w", false, "Watch cronjob status until it's ready or an error occurs")
 
 	_ = cronjobDeleteCmd.MarkFlagRequired("cronjob")
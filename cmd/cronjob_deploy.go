@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"github.com/pterm/pterm"
-	"os"
 
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/qovery/qovery-client-go"
@@ -14,48 +13,36 @@ import (
 var cronjobDeployCmd = &cobra.Command{
 	Use:   "deploy",
 	Short: "Deploy a cronjob",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if cronjobName == "" && cronjobNames == "" {
-			utils.PrintlnError(fmt.Errorf("use neither --cronjob \"<cronjob name>\" nor --cronjobs \"<cron1 name>, <cron2 name>\""))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("use neither --cronjob \"<cronjob name>\" nor --cronjobs \"<cron1 name>, <cron2 name>\"")
 		}
 
 		if cronjobName != "" && cronjobNames != "" {
-			utils.PrintlnError(fmt.Errorf("you can't use --cronjob and --cronjobs at the same time"))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("you can't use --cronjob and --cronjobs at the same time")
 		}
 
 		if cronjobTag != "" && cronjobCommitId != "" {
-			utils.PrintlnError(fmt.Errorf("you can't use --tag and --commit-id at the same time"))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("you can't use --tag and --commit-id at the same time")
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if !utils.IsEnvironmentInATerminalState(envId, client) {
-			utils.PrintlnError(fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
-				"for the end of the current operation to run your command. Try again in a few moment", envId))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
+				"for the end of the current operation to run your command. Try again in a few moment", envId)
 		}
 
 		if cronjobNames != "" {
@@ -63,35 +50,28 @@ var cronjobDeployCmd = &cobra.Command{
 			err := utils.DeployJobs(client, envId, cronjobNames, cronjobCommitId, cronjobTag)
 
 			if err != nil {
-				utils.PrintlnError(err)
-				os.Exit(1)
-				panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+				return err
 			}
 
 			utils.Println(fmt.Sprintf("Deploying cronjobs %s in progress..", pterm.FgBlue.Sprintf(cronjobNames)))
 
 			if watchFlag {
-				utils.WatchEnvironment(envId, "unused", client)
+				return utils.WatchEnvironment(envId, "unused", client)
 			}
 
-			return
+			return nil
 		}
 
 		cronjobs, err := ListCronjobs(envId, client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		cronjob := utils.FindByJobName(cronjobs, cronjobName)
 
 		if cronjob == nil {
-			utils.PrintlnError(fmt.Errorf("cronjob %s not found", cronjobName))
-			utils.PrintlnInfo("You can list all cronjobs with: qovery cronjob list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "cronjob", Name: cronjobName, Candidates: utils.JobNames(cronjobs)}, "You can list all cronjobs with: qovery cronjob list")
 		}
 
 		docker := cronjob.Source.Docker.Get()
@@ -120,25 +100,26 @@ var cronjobDeployCmd = &cobra.Command{
 		_, _, err = client.JobActionsApi.DeployJob(context.Background(), cronjob.Id).JobDeployRequest(req).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println("Cronjob is deploying!")
 
 		if watchFlag {
-			utils.WatchJob(cronjob.Id, envId, client)
+			return utils.WatchJob(cronjob.Id, envId, client)
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	cronjobCmd.AddCommand(cronjobDeployCmd)
-	cronjobDeployCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	cronjobDeployCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	cronjobDeployCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	cronjobDeployCmd.Flags().StringVarP(&cronjobName, "cronjob", "n", "", "Cronjob Name")
+	cronjobDeployCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	cronjobDeployCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	cronjobDeployCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	cronjobDeployCmd.Flags().StringVarP(&cronjobName, "cronjob", "n", "", "Cronjob Name or ID")
+	cronjobDeployCmd.Flags().StringVarP(&consoleUrl, "url", "", "", "Qovery console URL of the resource, instead of its names")
 	cronjobDeployCmd.Flags().StringVarP(&cronjobNames, "cronjobs", "", "", "Cronjob Names (comma separated) (ex: --cronjobs \"cron1,cron2\")")
 	cronjobDeployCmd.Flags().StringVarP(&cronjobCommitId, "commit-id", "c", "", "Lifecycle Commit ID")
 	cronjobDeployCmd.Flags().StringVarP(&cronjobTag, "tag", "t", "", "Lifecycle Tag")
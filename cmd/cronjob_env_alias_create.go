@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
@@ -13,60 +12,51 @@ import (
 var cronjobEnvAliasCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create cronjob environment variable or secret alias",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, projectId, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		cronjobs, _, err := client.JobsApi.ListJobs(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		cronjob := utils.FindByJobName(cronjobs.GetResults(), cronjobName)
 
 		if cronjob == nil {
-			utils.PrintlnError(fmt.Errorf("cronjob %s not found", cronjobName))
-			utils.PrintlnInfo("You can list all cronjobs with: qovery cronjob list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "cronjob", Name: cronjobName, Candidates: utils.JobNames(cronjobs.GetResults())}, "You can list all cronjobs with: qovery cronjob list")
 		}
 
 		err = utils.CreateAlias(client, projectId, envId, cronjob.Id, utils.JobType, utils.Key, utils.Alias, utils.JobScope)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Alias %s has been created", pterm.FgBlue.Sprintf(utils.Alias)))
+
+		return nil
 	},
 }
 
 func init() {
 	cronjobEnvAliasCmd.AddCommand(cronjobEnvAliasCreateCmd)
-	cronjobEnvAliasCreateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	cronjobEnvAliasCreateCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	cronjobEnvAliasCreateCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	cronjobEnvAliasCreateCmd.Flags().StringVarP(&cronjobName, "cronjob", "n", "", "Cronjob Name")
+	cronjobEnvAliasCreateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	cronjobEnvAliasCreateCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	cronjobEnvAliasCreateCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	cronjobEnvAliasCreateCmd.Flags().StringVarP(&cronjobName, "cronjob", "n", "", "Cronjob Name or ID")
 	cronjobEnvAliasCreateCmd.Flags().StringVarP(&utils.Key, "key", "k", "", "Environment variable or secret key")
 	cronjobEnvAliasCreateCmd.Flags().StringVarP(&utils.Alias, "alias", "", "", "Environment variable or secret alias")
 	cronjobEnvAliasCreateCmd.Flags().StringVarP(&utils.JobScope, "scope", "", "JOB", "Scope of this alias <PROJECT|ENVIRONMENT|JOB>")
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var cronjobEnvAliasDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete cronjob environment variable or secret alias",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		_, projectId, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		cronjobs, _, err := client.JobsApi.ListJobs(context.Background(), envId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		cronjob := utils.FindByJobName(cronjobs.GetResults(), cronjobName)
+
+		if cronjob == nil {
+			return utils.WithHint(&utils.NotFoundError{Level: "cronjob", Name: cronjobName, Candidates: utils.JobNames(cronjobs.GetResults())}, "You can list all cronjobs with: qovery cronjob list")
+		}
+
+		err = utils.DeleteAliasByKey(client, projectId, envId, cronjob.Id, utils.JobType, utils.Alias)
+
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("Alias %s has been deleted", pterm.FgBlue.Sprintf(utils.Alias)))
+
+		return nil
+	},
+}
+
+func init() {
+	cronjobEnvAliasCmd.AddCommand(cronjobEnvAliasDeleteCmd)
+	cronjobEnvAliasDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	cronjobEnvAliasDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	cronjobEnvAliasDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	cronjobEnvAliasDeleteCmd.Flags().StringVarP(&cronjobName, "cronjob", "n", "", "Cronjob Name or ID")
+	cronjobEnvAliasDeleteCmd.Flags().StringVarP(&utils.Alias, "alias", "", "", "Environment variable or secret alias")
+
+	_ = cronjobEnvAliasDeleteCmd.MarkFlagRequired("alias")
+	_ = cronjobEnvAliasDeleteCmd.MarkFlagRequired("cronjob")
+}
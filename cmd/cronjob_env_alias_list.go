@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var cronjobEnvAliasListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List cronjob environment variable and secret aliases",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+
+		_, _, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		cronjobs, _, err := client.JobsApi.ListJobs(context.Background(), envId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		cronjob := utils.FindByJobName(cronjobs.GetResults(), cronjobName)
+
+		if cronjob == nil {
+			return utils.WithHint(&utils.NotFoundError{Level: "cronjob", Name: cronjobName, Candidates: utils.JobNames(cronjobs.GetResults())}, "You can list all cronjobs with: qovery cronjob list")
+		}
+
+		envVars, _, err := client.JobEnvironmentVariableApi.ListJobEnvironmentVariable(
+			context.Background(),
+			cronjob.Id,
+		).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		secrets, _, err := client.JobSecretApi.ListJobSecrets(
+			context.Background(),
+			cronjob.Id,
+		).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		envVarLines := utils.NewEnvVarLines()
+
+		for _, envVar := range envVars.GetResults() {
+			if envVar.AliasedVariable != nil {
+				envVarLines.Add(utils.FromEnvironmentVariableToEnvVarLineOutput(envVar))
+			}
+		}
+
+		for _, secret := range secrets.GetResults() {
+			if secret.AliasedSecret != nil {
+				envVarLines.Add(utils.FromSecretToEnvVarLineOutput(secret))
+			}
+		}
+
+		err = utils.PrintTable(envVarLines.Header(false), envVarLines.Lines(utils.ShowValues, false))
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	cronjobEnvAliasCmd.AddCommand(cronjobEnvAliasListCmd)
+	cronjobEnvAliasListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	cronjobEnvAliasListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	cronjobEnvAliasListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	cronjobEnvAliasListCmd.Flags().StringVarP(&cronjobName, "cronjob", "n", "", "Cronjob Name or ID")
+	cronjobEnvAliasListCmd.Flags().BoolVarP(&utils.ShowValues, "show-values", "", false, "Show env var values")
+
+	_ = cronjobEnvAliasListCmd.MarkFlagRequired("cronjob")
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var cronjobEnvUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update cronjob environment variable or secret value",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		_, projectId, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		cronjobs, _, err := client.JobsApi.ListJobs(context.Background(), envId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		cronjob := utils.FindByJobName(cronjobs.GetResults(), cronjobName)
+
+		if cronjob == nil {
+			return utils.WithHint(&utils.NotFoundError{Level: "cronjob", Name: cronjobName, Candidates: utils.JobNames(cronjobs.GetResults())}, "You can list all cronjobs with: qovery cronjob list")
+		}
+
+		value, err := utils.ReadValue(utils.Value, utils.ValueFromFile, utils.ValueFromStdin)
+
+		if err != nil {
+			return err
+		}
+
+		err = utils.UpdateByKey(client, projectId, envId, cronjob.Id, utils.JobType, utils.Key, value)
+
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("Variable %s has been updated", pterm.FgBlue.Sprintf(utils.Key)))
+
+		return nil
+	},
+}
+
+func init() {
+	cronjobEnvCmd.AddCommand(cronjobEnvUpdateCmd)
+	cronjobEnvUpdateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	cronjobEnvUpdateCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	cronjobEnvUpdateCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	cronjobEnvUpdateCmd.Flags().StringVarP(&cronjobName, "cronjob", "n", "", "Cronjob Name or ID")
+	cronjobEnvUpdateCmd.Flags().StringVarP(&utils.Key, "key", "k", "", "Environment variable or secret key")
+	cronjobEnvUpdateCmd.Flags().StringVarP(&utils.Value, "value", "v", "", "Environment variable or secret value")
+	cronjobEnvUpdateCmd.Flags().StringVarP(&utils.ValueFromFile, "value-from-file", "", "", "Read the environment variable or secret value from a file")
+	cronjobEnvUpdateCmd.Flags().BoolVarP(&utils.ValueFromStdin, "value-from-stdin", "", false, "Read the environment variable or secret value from stdin")
+
+	_ = cronjobEnvUpdateCmd.MarkFlagRequired("key")
+	_ = cronjobEnvUpdateCmd.MarkFlagRequired("cronjob")
+}
@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"os"
 
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
@@ -11,39 +10,31 @@ import (
 var cronjobListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List cronjobs",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		cronjobs, err := ListCronjobs(envId, client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		statuses, _, err := client.EnvironmentMainCallsApi.GetEnvironmentStatuses(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		var data [][]string
@@ -56,16 +47,16 @@ var cronjobListCmd = &cobra.Command{
 		err = utils.PrintTable([]string{"Name", "Type", "Status", "Last Update"}, data)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	cronjobCmd.AddCommand(cronjobListCmd)
-	cronjobListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	cronjobListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	cronjobListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
+	cronjobListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	cronjobListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	cronjobListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
 }
@@ -8,10 +8,12 @@ import (
 var databaseCancelCmd = &cobra.Command{
 	Use:   "cancel",
 	Short: "Cancel a database deployment",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		utils.PrintlnInfo("Use: 'qovery environment cancel' to cancel this deployment")
+
+		return nil
 	},
 }
 
@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
@@ -13,71 +12,60 @@ import (
 var databaseDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete a database",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if !utils.IsEnvironmentInATerminalState(envId, client) {
-			utils.PrintlnError(fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
-				"for the end of the current operation to run your command. Try again in a few moment", envId))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
+				"for the end of the current operation to run your command. Try again in a few moment", envId)
 		}
 
 		databases, _, err := client.DatabasesApi.ListDatabase(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		database := utils.FindByDatabaseName(databases.GetResults(), databaseName)
 
 		if database == nil {
-			utils.PrintlnError(fmt.Errorf("database %s not found", databaseName))
-			utils.PrintlnInfo("You can list all databases with: qovery database list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "database", Name: databaseName, Candidates: utils.DatabaseNames(databases.GetResults())}, "You can list all databases with: qovery database list")
 		}
 
 		_, err = client.DatabaseMainCallsApi.DeleteDatabase(context.Background(), database.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Deleting database %s in progress..", pterm.FgBlue.Sprintf(databaseName)))
 
 		if watchFlag {
-			utils.WatchDatabase(database.Id, envId, client)
+			return utils.WatchDatabase(database.Id, envId, client)
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	databaseCmd.AddCommand(databaseDeleteCmd)
-	databaseDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	databaseDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	databaseDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	databaseDeleteCmd.Flags().StringVarP(&databaseName, "database", "n", "", "Database Name")
+	databaseDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	databaseDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	databaseDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	databaseDeleteCmd.Flags().StringVarP(&databaseName, "database", "n", "", "Database Name or ID")
 	databaseDeleteCmd.Flags().BoolVarP(&watchFlag, "watch", "w", false, "Watch database status until it's ready or an error occurs")
 
 	_ = databaseDeleteCmd.MarkFlagRequired("database")
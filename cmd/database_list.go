@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"os"
 	"strconv"
 
 	"github.com/qovery/qovery-cli/utils"
@@ -12,14 +11,12 @@ import (
 var databaseListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List databases",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
@@ -27,25 +24,19 @@ var databaseListCmd = &cobra.Command{
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		databases, _, err := client.DatabasesApi.ListDatabase(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		statuses, _, err := client.EnvironmentMainCallsApi.GetEnvironmentStatuses(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		var data [][]string
@@ -53,9 +44,7 @@ var databaseListCmd = &cobra.Command{
 		for _, database := range databases.GetResults() {
 			res, _, err := client.DatabaseMainCallsApi.GetDatabaseMasterCredentials(context.Background(), database.Id).Execute()
 			if err != nil {
-				utils.PrintlnError(err)
-				os.Exit(1)
-				panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+				return err
 			}
 
 			login := "********"
@@ -78,17 +67,17 @@ var databaseListCmd = &cobra.Command{
 		err = utils.PrintTable([]string{"Name", "Type", "Status", "Host", "Port", "Login", "Password", "Last Update"}, data)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	databaseCmd.AddCommand(databaseListCmd)
-	databaseListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	databaseListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	databaseListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
+	databaseListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	databaseListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	databaseListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
 	databaseListCmd.Flags().BoolVarP(&showCredentials, "show-credentials", "", false, "Show Credentials")
 }
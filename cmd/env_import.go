@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
+	vault "github.com/hashicorp/vault/api"
 	"github.com/joho/godotenv"
 	"github.com/manifoldco/promptui"
 	"github.com/qovery/qovery-cli/utils"
@@ -16,45 +17,42 @@ import (
 var envImportCmd = &cobra.Command{
 	Use:   "import",
 	Short: "Import environment variables/secrets for a Qovery app",
-	Run: func(cmd *cobra.Command, args []string) {
+	Long: `IMPORT uploads the variables of a dot env file to the current application.
+Values like vault://secret/data/my-app#DATABASE_PASSWORD are read from Vault (VAULT_ADDR, VAULT_TOKEN)
+and files encrypted with SOPS are decrypted locally with 'sops'. Both are always imported as secrets.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		dotEnvFilePath := ""
 		if len(args) == 0 {
 			file, err := scanAndSelectDotEnvFile()
 			if err != nil {
-				utils.PrintlnError(err)
-				return
+				return err
 			}
 
 			dotEnvFilePath = file
 		} else if len(args) == 1 {
 			dotEnvFilePath = args[0]
 		} else {
-			utils.PrintlnError(fmt.Errorf("more than one arg is not allowed"))
-			return
+			return fmt.Errorf("more than one arg is not allowed")
 		}
 
 		if dotEnvFilePath == "" {
-			utils.PrintlnError(fmt.Errorf("no dot env file specified"))
-			return
+			return fmt.Errorf("no dot env file specified")
 		}
 
-		envs, err := godotenv.Read(dotEnvFilePath)
+		envs, encrypted, err := readDotEnvFile(dotEnvFilePath)
 		if err != nil {
-			utils.PrintlnError(err)
-			return
+			return err
 		}
 
 		service, err := utils.CurrentService()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(0)
+			return err
 		}
 
 		if service.Type != utils.ApplicationType {
-			utils.PrintlnError(fmt.Errorf("cannot import variables for service different than Application"))
-			os.Exit(0)
+			return fmt.Errorf("cannot import variables for service different than Application")
 		}
 
 		utils.PrintlnInfo(fmt.Sprintf("dot env file to import: '%s'", dotEnvFilePath))
@@ -67,8 +65,7 @@ var envImportCmd = &cobra.Command{
 		var envVarOrSecret string
 		err = survey.AskOne(prompt, &envVarOrSecret)
 		if err != nil {
-			utils.PrintlnError(err)
-			return
+			return err
 		}
 
 		isSecrets := false
@@ -76,10 +73,15 @@ var envImportCmd = &cobra.Command{
 			isSecrets = true
 		}
 
-		envsToImport := getEnvsToImport(envs)
+		envsToImport := getEnvsToImport(envs, encrypted)
 		if len(envsToImport) == 0 {
-			utils.PrintlnError(fmt.Errorf("no environment variables to import"))
-			return
+			return fmt.Errorf("no environment variables to import")
+		}
+
+		// values coming from a secret store are always imported as secrets
+		forcedSecrets, err := resolveSecretReferences(envsToImport, encrypted)
+		if err != nil {
+			return err
 		}
 
 		prompt = &survey.Select{
@@ -90,8 +92,7 @@ var envImportCmd = &cobra.Command{
 		var overrideEnvVarOrSecretString string
 		err = survey.AskOne(prompt, &overrideEnvVarOrSecretString)
 		if err != nil {
-			utils.PrintlnError(err)
-			return
+			return err
 		}
 
 		overrideEnvVarOrSecret := false
@@ -99,16 +100,35 @@ var envImportCmd = &cobra.Command{
 			overrideEnvVarOrSecret = true
 		}
 
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+
+		projectId, _, err := utils.CurrentProject()
+		if err != nil {
+			return err
+		}
+
+		environmentId, _, err := utils.CurrentEnvironment()
+		if err != nil {
+			return err
+		}
+
 		var errors []string
 
 		for k, v := range envsToImport {
 			var err error
-			if isSecrets {
+			if isSecrets || forcedSecrets[k] {
 				if overrideEnvVarOrSecret {
+					// a key forced to be a secret may exist as a plain variable, which would conflict with the secret
+					_ = utils.DeleteEnvironmentVariable(service.ID, k)
 					_ = utils.DeleteSecret(service.ID, k)
 				}
 
-				err = utils.AddSecret(service.ID, k, v)
+				err = utils.CreateSecret(client, string(projectId), string(environmentId), string(service.ID), k, v, "APPLICATION")
 			} else {
 				if overrideEnvVarOrSecret {
 					_ = utils.DeleteEnvironmentVariable(service.ID, k)
@@ -122,11 +142,12 @@ var envImportCmd = &cobra.Command{
 			}
 		}
 
-		if len(errors) == 0 {
-			utils.PrintlnInfo(fmt.Sprintf("✅ %s successfully imported!", envVarOrSecret))
-		} else {
-			utils.PrintlnError(fmt.Errorf("those %s have failed to be imported: %s", envVarOrSecret, strings.Join(errors, ", ")))
+		if len(errors) > 0 {
+			return fmt.Errorf("those %s have failed to be imported: %s", envVarOrSecret, strings.Join(errors, ", "))
 		}
+
+		utils.PrintlnInfo(fmt.Sprintf("✅ %s successfully imported!", envVarOrSecret))
+		return nil
 	},
 }
 
@@ -146,7 +167,7 @@ func scanAndSelectDotEnvFile() (string, error) {
 	})
 
 	if err != nil {
-		panic(err)
+		return "", err
 	}
 
 	if len(files) == 0 {
@@ -167,10 +188,66 @@ func scanAndSelectDotEnvFile() (string, error) {
 	return result, nil
 }
 
-func getEnvsToImport(envs map[string]string) map[string]string {
+// readDotEnvFile reads a plain or SOPS encrypted dot env file, encrypted files are decrypted in memory only
+func readDotEnvFile(path string) (map[string]string, bool, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if utils.IsSopsEncrypted(content) {
+		envs, err := utils.DecryptSopsDotEnv(path)
+		return envs, true, err
+	}
+
+	envs, err := godotenv.UnmarshalBytes(content)
+	return envs, false, err
+}
+
+// resolveSecretReferences replaces vault://path#key references by their value and returns the keys
+// that must be imported as secrets
+func resolveSecretReferences(envs map[string]string, encrypted bool) (map[string]bool, error) {
+	forcedSecrets := make(map[string]bool)
+	var vaultClient *vault.Client
+
+	for k, v := range envs {
+		if encrypted {
+			forcedSecrets[k] = true
+		}
+
+		if !utils.IsVaultReference(v) {
+			continue
+		}
+
+		if vaultClient == nil {
+			client, err := utils.NewVaultClient()
+			if err != nil {
+				return nil, err
+			}
+
+			vaultClient = client
+		}
+
+		value, err := utils.ResolveVaultReference(vaultClient, v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", k, err)
+		}
+
+		envs[k] = value
+		forcedSecrets[k] = true
+	}
+
+	return forcedSecrets, nil
+}
+
+func getEnvsToImport(envs map[string]string, hideValues bool) map[string]string {
 	var envKeys []string
 
 	for k, v := range envs {
+		if hideValues {
+			v = "********"
+		}
+
 		envKeys = append(envKeys, fmt.Sprintf("%s=%s", k, v))
 	}
 
@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/joho/godotenv"
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/qovery/qovery-client-go"
+	"github.com/spf13/cobra"
+)
+
+var requireFile string
+
+var envLintCmd = &cobra.Command{
+	Use:   "lint",
+	Short: "Check the environment variables and secrets of a service before deploying it",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		_, _, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		serviceId, serviceType, err := getLintedService(client, envId)
+
+		if err != nil {
+			return err
+		}
+
+		var requiredKeys []string
+		if requireFile != "" {
+			requiredEnvs, err := godotenv.Read(requireFile)
+
+			if err != nil {
+				return err
+			}
+
+			for key := range requiredEnvs {
+				requiredKeys = append(requiredKeys, key)
+			}
+			sort.Strings(requiredKeys)
+		}
+
+		envVars, err := utils.ListEnvironmentVariables(client, serviceId, serviceType)
+
+		if err != nil {
+			return err
+		}
+
+		secrets, err := utils.ListSecrets(client, serviceId, serviceType)
+
+		if err != nil {
+			return err
+		}
+
+		issues := utils.LintEnvironmentVariables(envVars, secrets, requiredKeys)
+
+		if len(issues) == 0 {
+			utils.Println("✅ No issue found")
+			return nil
+		}
+
+		var data [][]string
+		errorCount := 0
+
+		for _, issue := range issues {
+			level := pterm.FgYellow.Sprintf(string(issue.Level))
+			if issue.Level == utils.LintError {
+				level = pterm.FgRed.Sprintf(string(issue.Level))
+				errorCount++
+			}
+
+			data = append(data, []string{level, issue.Key, issue.Message})
+		}
+
+		err = utils.PrintTable([]string{"Level", "Key", "Message"}, data)
+
+		if err != nil {
+			return err
+		}
+
+		if errorCount > 0 {
+			return fmt.Errorf("%d error(s) found", errorCount)
+		}
+
+		return nil
+	},
+}
+
+func getLintedService(client *qovery.APIClient, envId string) (string, utils.ServiceType, error) {
+	switch {
+	case applicationName != "":
+		applications, _, err := client.ApplicationsApi.ListApplication(context.Background(), envId).Execute()
+		if err != nil {
+			return "", "", err
+		}
+
+		application := utils.FindByApplicationName(applications.GetResults(), applicationName)
+		if application == nil {
+			return "", "", &utils.NotFoundError{Level: "application", Name: applicationName, Candidates: utils.ApplicationNames(applications.GetResults())}
+		}
+
+		return application.Id, utils.ApplicationType, nil
+	case containerName != "":
+		containers, _, err := client.ContainersApi.ListContainer(context.Background(), envId).Execute()
+		if err != nil {
+			return "", "", err
+		}
+
+		container := utils.FindByContainerName(containers.GetResults(), containerName)
+		if container == nil {
+			return "", "", &utils.NotFoundError{Level: "container", Name: containerName, Candidates: utils.ContainerNames(containers.GetResults())}
+		}
+
+		return container.Id, utils.ContainerType, nil
+	case cronjobName != "" || lifecycleName != "":
+		name := cronjobName
+		if name == "" {
+			name = lifecycleName
+		}
+
+		jobs, _, err := client.JobsApi.ListJobs(context.Background(), envId).Execute()
+		if err != nil {
+			return "", "", err
+		}
+
+		job := utils.FindByJobName(jobs.GetResults(), name)
+		if job == nil {
+			return "", "", &utils.NotFoundError{Level: "job", Name: name, Candidates: utils.JobNames(jobs.GetResults())}
+		}
+
+		return job.Id, utils.JobType, nil
+	}
+
+	return "", "", fmt.Errorf("one of --application, --container, --cronjob or --lifecycle is required")
+}
+
+func init() {
+	envCmd.AddCommand(envLintCmd)
+	envLintCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	envLintCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	envLintCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	envLintCmd.Flags().StringVarP(&applicationName, "application", "", "", "Application Name or ID")
+	envLintCmd.Flags().StringVarP(&containerName, "container", "", "", "Container Name or ID")
+	envLintCmd.Flags().StringVarP(&cronjobName, "cronjob", "", "", "Cronjob Name or ID")
+	envLintCmd.Flags().StringVarP(&lifecycleName, "lifecycle", "", "", "Lifecycle Name or ID")
+	envLintCmd.Flags().StringVarP(&requireFile, "require-file", "", "", "Dot env file (e.g. .env.example) listing the keys that must be defined")
+}
@@ -1,73 +1,117 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
+	"path/filepath"
+
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
-	"os"
 )
 
 var parseHerokuJson bool
+var parseRailwayJson bool
+var parseDockerCompose bool
+var parseKubernetes bool
+var parseRenderYaml bool
+var parseFlyToml bool
+var parseVercelEnv bool
+var parseTfvars bool
+var composeServiceName string
 
 var envParseCmd = &cobra.Command{
-	Use:   "parse",
+	Use:   "parse [file]",
 	Short: "Parse environment variables and create .env (dot env) file",
-	Run: func(cmd *cobra.Command, args []string) {
+	Long: `PARSE reads environment variables from another platform and prints them as a KEY=VALUE stream.
+The input is read from the given file or from stdin. Without any format option, the input is a dot env file, selected
+among the ones of the current directory when neither a file is given nor stdin is piped. For example:
+
+  heroku config -a my-app --json | qovery env parse --heroku-json > .env
+  qovery env parse --docker-compose docker-compose.yml --compose-service api > .env
+  kubectl get configmap my-config -o yaml | qovery env parse --kubernetes > .env`,
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		if len(args) > 1 {
-			utils.PrintlnError(fmt.Errorf("more than one arg is not allowed"))
-			return
+			return fmt.Errorf("more than one arg is not allowed")
+		}
+
+		formats := 0
+		for _, enabled := range []bool{parseHerokuJson, parseRailwayJson, parseDockerCompose, parseKubernetes, parseRenderYaml, parseFlyToml, parseVercelEnv, parseTfvars} {
+			if enabled {
+				formats++
+			}
 		}
 
-		if !parseHerokuJson {
-			utils.PrintlnError(fmt.Errorf("you need to specify an option like '--heroku-json' or other. Type -h to see different options"))
-			return
+		if formats > 1 {
+			return fmt.Errorf("only one format option can be used at a time. Type -h to see different options")
 		}
 
-		dotEnvFilePath := ""
-		if len(args) == 0 && !parseHerokuJson {
+		path := ""
+		if len(args) == 1 {
+			path = args[0]
+		} else if formats == 0 && utils.StdinIsTerminal() {
+			// piped input is parsed, the dot env files of the current directory are only offered interactively
 			file, err := scanAndSelectDotEnvFile()
 			if err != nil {
-				utils.PrintlnError(err)
-				return
+				return err
 			}
 
-			dotEnvFilePath = file
-		} else if len(args) == 1 && !parseHerokuJson {
-			dotEnvFilePath = args[0]
-		} else if !parseHerokuJson {
-			utils.PrintlnError(fmt.Errorf("more than one arg is not allowed"))
-			return
+			path = file
+		} else if parseDockerCompose {
+			path = "docker-compose.yml"
 		}
 
-		if dotEnvFilePath == "" && !parseHerokuJson {
-			utils.PrintlnError(fmt.Errorf("no dot env file specified"))
-			return
+		content, err := utils.ReadFileOrStdin(path)
+		if err != nil {
+			return err
 		}
 
 		var envs map[string]string
-		var err error
 
-		if parseHerokuJson {
-			envs = make(map[string]string)
-			err = json.NewDecoder(os.Stdin).Decode(&envs)
+		switch {
+		case parseHerokuJson:
+			envs, err = utils.ParseJsonEnvs(content)
+			if err != nil {
+				return utils.WithHint(err, "Did you execute 'heroku config -a <your_heroku_app_name> --json' command?")
+			}
+		case parseRailwayJson:
+			envs, err = utils.ParseJsonEnvs(content)
+			if err != nil {
+				return utils.WithHint(err, "Did you execute 'railway variables --json' command?")
+			}
+		case parseDockerCompose:
+			envs, err = utils.ParseDockerCompose(content, filepath.Dir(path), composeServiceName)
+		case parseKubernetes:
+			envs, err = utils.ParseKubernetesManifests(content)
+		case parseRenderYaml:
+			envs, err = utils.ParseRenderBlueprint(content)
+		case parseFlyToml:
+			envs, err = utils.ParseFlyToml(content)
+		case parseTfvars:
+			envs, err = utils.ParseTfvars(content)
+		default:
+			envs, err = utils.ParseDotEnv(content, parseVercelEnv)
 		}
 
 		if err != nil {
-			utils.PrintlnError(err)
-			utils.Println("Did you execute 'heroku config -a <your_heroku_app_name> --json' command?")
-			return
+			return err
 		}
 
-		for key, value := range envs {
-			fmt.Printf("%s=%s\n", key, value)
-		}
+		fmt.Print(utils.FormatDotEnv(envs))
+
+		return nil
 	},
 }
 
 func init() {
 	envCmd.AddCommand(envParseCmd)
 	envParseCmd.Flags().BoolVarP(&parseHerokuJson, "heroku-json", "j", false, "Parse environment variables from a Heroku JSON payload")
+	envParseCmd.Flags().BoolVarP(&parseRailwayJson, "railway-json", "", false, "Parse environment variables from 'railway variables --json' output")
+	envParseCmd.Flags().BoolVarP(&parseDockerCompose, "docker-compose", "", false, "Parse 'environment' and 'env_file' of a docker-compose file")
+	envParseCmd.Flags().StringVarP(&composeServiceName, "compose-service", "", "", "Only parse this docker-compose service")
+	envParseCmd.Flags().BoolVarP(&parseKubernetes, "kubernetes", "", false, "Parse Kubernetes ConfigMap and Secret YAML manifests")
+	envParseCmd.Flags().BoolVarP(&parseRenderYaml, "render-yaml", "", false, "Parse environment variables of a Render blueprint (render.yaml)")
+	envParseCmd.Flags().BoolVarP(&parseFlyToml, "fly-toml", "", false, "Parse the [env] section of a Fly fly.toml file")
+	envParseCmd.Flags().BoolVarP(&parseVercelEnv, "vercel-env", "", false, "Parse a 'vercel env pull' file, dropping Vercel system variables")
+	envParseCmd.Flags().BoolVarP(&parseTfvars, "tfvars", "", false, "Parse Terraform variable definitions (.tfvars)")
 }
@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"os"
 
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/qovery/qovery-client-go"
@@ -12,45 +11,41 @@ import (
 var environmentCancelCmd = &cobra.Command{
 	Use:   "cancel",
 	Short: "Cancel an environment deployment",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		_, _, err = client.EnvironmentActionsApi.CancelEnvironmentDeployment(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println("Environment is canceling!")
 
 		if watchFlag {
-			utils.WatchEnvironment(envId, qovery.STATEENUM_CANCELED, client)
+			return utils.WatchEnvironment(envId, qovery.STATEENUM_CANCELED, client)
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	environmentCmd.AddCommand(environmentCancelCmd)
-	environmentCancelCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	environmentCancelCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	environmentCancelCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
+	environmentCancelCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	environmentCancelCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	environmentCancelCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
 	environmentCancelCmd.Flags().BoolVarP(&watchFlag, "watch", "w", false, "Watch environment status until it's ready or an error occurs")
 }
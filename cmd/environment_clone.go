@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"os"
 	"strings"
 
 	"github.com/qovery/qovery-cli/utils"
@@ -13,23 +12,19 @@ import (
 var environmentCloneCmd = &cobra.Command{
 	Use:   "clone",
 	Short: "Clone an environment",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		orgId, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		req := qovery.CloneRequest{
@@ -63,19 +58,19 @@ var environmentCloneCmd = &cobra.Command{
 		_, _, err = client.EnvironmentActionsApi.CloneEnvironment(context.Background(), envId).CloneRequest(req).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println("Environment is cloned!")
+
+		return nil
 	},
 }
 
 func init() {
 	environmentCmd.AddCommand(environmentCloneCmd)
-	environmentCloneCmd.Flags().StringVarP(&organizationName, "organization", "o", "", "Organization Name")
-	environmentCloneCmd.Flags().StringVarP(&projectName, "project", "p", "", "Project Name")
+	environmentCloneCmd.Flags().StringVarP(&organizationName, "organization", "o", "", "Organization Name or ID")
+	environmentCloneCmd.Flags().StringVarP(&projectName, "project", "p", "", "Project Name or ID")
 	environmentCloneCmd.Flags().StringVarP(&environmentName, "environment", "e", "", "Environment Name to clone")
 	environmentCloneCmd.Flags().StringVarP(&newEnvironmentName, "new-environment-name", "n", "", "New Environment Name")
 	environmentCloneCmd.Flags().StringVarP(&clusterName, "cluster", "c", "", "Cluster Name where to clone the environment")
@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/qovery/qovery-client-go"
@@ -13,52 +12,46 @@ import (
 var environmentDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete an environment",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if !utils.IsEnvironmentInATerminalState(envId, client) {
-			utils.PrintlnError(fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
-				"for the end of the current operation to run your command. Try again in a few moment", envId))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
+				"for the end of the current operation to run your command. Try again in a few moment", envId)
 		}
 
 		_, err = client.EnvironmentMainCallsApi.DeleteEnvironment(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println("Environment is deleting!")
 
 		if watchFlag {
-			utils.WatchEnvironment(envId, qovery.STATEENUM_DELETED, client)
+			return utils.WatchEnvironment(envId, qovery.STATEENUM_DELETED, client)
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	environmentCmd.AddCommand(environmentDeleteCmd)
-	environmentDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	environmentDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	environmentDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
+	environmentDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	environmentDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	environmentDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
 	environmentDeleteCmd.Flags().BoolVarP(&watchFlag, "watch", "w", false, "Watch environment status until it's ready or an error occurs")
 }
@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/qovery/qovery-client-go"
@@ -13,52 +12,47 @@ import (
 var environmentDeployCmd = &cobra.Command{
 	Use:   "deploy",
 	Short: "Deploy an environment",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if !utils.IsEnvironmentInATerminalState(envId, client) {
-			utils.PrintlnError(fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
-				"for the end of the current operation to run your command. Try again in a few moment", environmentName))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
+				"for the end of the current operation to run your command. Try again in a few moment", environmentName)
 		}
 
 		_, _, err = client.EnvironmentActionsApi.DeployEnvironment(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println("Environment is deploying!")
 
 		if watchFlag {
-			utils.WatchEnvironment(envId, qovery.STATEENUM_RUNNING, client)
+			return utils.WatchEnvironment(envId, qovery.STATEENUM_RUNNING, client)
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	environmentCmd.AddCommand(environmentDeployCmd)
-	environmentDeployCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	environmentDeployCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	environmentDeployCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
+	environmentDeployCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	environmentDeployCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	environmentDeployCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	environmentDeployCmd.Flags().StringVarP(&consoleUrl, "url", "", "", "Qovery console URL of the resource, instead of its names")
 	environmentDeployCmd.Flags().BoolVarP(&watchFlag, "watch", "w", false, "Watch environment status until it's ready or an error occurs")
 }
@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var environmentEnvCmd = &cobra.Command{
+	Use:   "env",
+	Short: "Manage environment variables and secrets shared by all services of an environment",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		if len(args) == 0 {
+			return cmd.Help()
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	environmentCmd.AddCommand(environmentEnvCmd)
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var environmentEnvCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create environment environment variable or secret",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		_, projectId, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		if utils.IsSecret {
+			err = utils.CreateSecret(client, projectId, envId, "", utils.Key, utils.Value, "ENVIRONMENT")
+
+			if err != nil {
+				return err
+			}
+
+			utils.Println(fmt.Sprintf("Secret %s has been created", pterm.FgBlue.Sprintf(utils.Key)))
+			return nil
+		}
+
+		err = utils.CreateEnvironmentVariable(client, projectId, envId, "", utils.Key, utils.Value, "ENVIRONMENT")
+
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("Environment variable %s has been created", pterm.FgBlue.Sprintf(utils.Key)))
+
+		return nil
+	},
+}
+
+func init() {
+	environmentEnvCmd.AddCommand(environmentEnvCreateCmd)
+	environmentEnvCreateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	environmentEnvCreateCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	environmentEnvCreateCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	environmentEnvCreateCmd.Flags().StringVarP(&utils.Key, "key", "k", "", "Environment variable or secret key")
+	environmentEnvCreateCmd.Flags().StringVarP(&utils.Value, "value", "v", "", "Environment variable or secret value")
+	environmentEnvCreateCmd.Flags().BoolVarP(&utils.IsSecret, "secret", "", false, "This environment variable is a secret")
+
+	_ = environmentEnvCreateCmd.MarkFlagRequired("key")
+	_ = environmentEnvCreateCmd.MarkFlagRequired("value")
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var environmentEnvDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete environment environment variable or secret",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		_, projectId, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		err = utils.DeleteByKeyAndScope(client, projectId, envId, utils.Key, "ENVIRONMENT")
+
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("Variable %s has been deleted", pterm.FgBlue.Sprintf(utils.Key)))
+
+		return nil
+	},
+}
+
+func init() {
+	environmentEnvCmd.AddCommand(environmentEnvDeleteCmd)
+	environmentEnvDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	environmentEnvDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	environmentEnvDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	environmentEnvDeleteCmd.Flags().StringVarP(&utils.Key, "key", "k", "", "Environment variable or secret key")
+
+	_ = environmentEnvDeleteCmd.MarkFlagRequired("key")
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var environmentEnvListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List environment environment variables",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		_, projectId, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		if utils.ShowTree {
+			if utils.Key == "" {
+				return fmt.Errorf("--key is required to display the tree of a variable")
+			}
+
+			tree, err := utils.BuildEnvVarTree(client, projectId, envId, utils.Key, "ENVIRONMENT")
+
+			if err != nil {
+				return err
+			}
+
+			err = pterm.DefaultTree.WithRoot(*tree).Render()
+
+			if err != nil {
+				return err
+			}
+
+			return nil
+		}
+
+		envVars, err := utils.ListEnvironmentVariablesByScope(client, projectId, envId, "ENVIRONMENT")
+
+		if err != nil {
+			return err
+		}
+
+		secrets, err := utils.ListSecretsByScope(client, projectId, envId, "ENVIRONMENT")
+
+		if err != nil {
+			return err
+		}
+
+		envVarLines := utils.NewEnvVarLines()
+
+		for _, envVar := range envVars {
+			envVarLines.Add(utils.FromEnvironmentVariableToEnvVarLineOutput(envVar))
+		}
+
+		for _, secret := range secrets {
+			envVarLines.Add(utils.FromSecretToEnvVarLineOutput(secret))
+		}
+
+		err = utils.PrintTable(envVarLines.Header(utils.PrettyPrint), envVarLines.Lines(utils.ShowValues, utils.PrettyPrint))
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	environmentEnvCmd.AddCommand(environmentEnvListCmd)
+	environmentEnvListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	environmentEnvListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	environmentEnvListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	environmentEnvListCmd.Flags().StringVarP(&consoleUrl, "url", "", "", "Qovery console URL of the resource, instead of its names")
+	environmentEnvListCmd.Flags().BoolVarP(&utils.ShowValues, "show-values", "", false, "Show env var values")
+	environmentEnvListCmd.Flags().BoolVarP(&utils.PrettyPrint, "pretty-print", "", false, "Pretty print output")
+	environmentEnvListCmd.Flags().BoolVarP(&utils.ShowTree, "tree", "", false, "Show the aliases and overrides depending on --key across environments and services")
+	environmentEnvListCmd.Flags().StringVarP(&utils.Key, "key", "k", "", "Environment variable or secret key")
+}
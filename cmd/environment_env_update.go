@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var environmentEnvUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update environment environment variable or secret value",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		_, projectId, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		value, err := utils.ReadValue(utils.Value, utils.ValueFromFile, utils.ValueFromStdin)
+
+		if err != nil {
+			return err
+		}
+
+		err = utils.UpdateByKeyAndScope(client, projectId, envId, utils.Key, value, "ENVIRONMENT")
+
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("Variable %s has been updated", pterm.FgBlue.Sprintf(utils.Key)))
+
+		return nil
+	},
+}
+
+func init() {
+	environmentEnvCmd.AddCommand(environmentEnvUpdateCmd)
+	environmentEnvUpdateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	environmentEnvUpdateCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	environmentEnvUpdateCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	environmentEnvUpdateCmd.Flags().StringVarP(&utils.Key, "key", "k", "", "Environment variable or secret key")
+	environmentEnvUpdateCmd.Flags().StringVarP(&utils.Value, "value", "v", "", "Environment variable or secret value")
+	environmentEnvUpdateCmd.Flags().StringVarP(&utils.ValueFromFile, "value-from-file", "", "", "Read the environment variable or secret value from a file")
+	environmentEnvUpdateCmd.Flags().BoolVarP(&utils.ValueFromStdin, "value-from-stdin", "", false, "Read the environment variable or secret value from stdin")
+
+	_ = environmentEnvUpdateCmd.MarkFlagRequired("key")
+}
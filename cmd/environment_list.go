@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"os"
 
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
@@ -11,39 +10,31 @@ import (
 var environmentListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List environments",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
-		_, projectId, _, err := getContextResourcesId(client)
+		_, projectId, err := getProjectResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		environments, _, err := client.EnvironmentsApi.ListEnvironment(context.Background(), projectId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		statuses, _, err := client.EnvironmentsApi.GetProjectEnvironmentsStatus(context.Background(), projectId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		var data [][]string
@@ -56,15 +47,15 @@ var environmentListCmd = &cobra.Command{
 		err = utils.PrintTable([]string{"Name", "Cluster", "Type", "Status", "Last Update"}, data)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	environmentCmd.AddCommand(environmentListCmd)
-	environmentListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	environmentListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
+	environmentListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	environmentListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
 }
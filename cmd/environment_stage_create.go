@@ -5,29 +5,24 @@ import (
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/qovery/qovery-client-go"
 	"github.com/spf13/cobra"
-	"os"
 )
 
 var environmentStageCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create deployment stage",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, environmentId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		req := qovery.DeploymentStageRequest{
@@ -44,20 +39,20 @@ var environmentStageCreateCmd = &cobra.Command{
 		_, _, err = client.DeploymentStageMainCallsApi.CreateEnvironmentDeploymentStage(context.Background(), environmentId).DeploymentStageRequest(req).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println("Stage created successfully")
+
+		return nil
 	},
 }
 
 func init() {
 	environmentStageCmd.AddCommand(environmentStageCreateCmd)
-	environmentStageCreateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	environmentStageCreateCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	environmentStageCreateCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
+	environmentStageCreateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	environmentStageCreateCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	environmentStageCreateCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
 	environmentStageCreateCmd.Flags().StringVarP(&stageName, "name", "n", "", "Stage Name")
 	environmentStageCreateCmd.Flags().StringVarP(&stageDescription, "description", "d", "", "Stage Description")
 
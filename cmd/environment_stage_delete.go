@@ -2,60 +2,50 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/qovery/qovery-client-go"
 	"github.com/spf13/cobra"
-	"os"
 )
 
 var environmentStageDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete deployment stage",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, environmentId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		stages, _, err := client.DeploymentStageMainCallsApi.ListEnvironmentDeploymentStage(context.Background(), environmentId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		stage, err := GetStageByName(stages.GetResults(), stageName)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		_, err = client.DeploymentStageMainCallsApi.DeleteDeploymentStage(context.Background(), stage.GetId()).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println("Stage deleted successfully")
+
+		return nil
 	},
 }
 
@@ -66,14 +56,23 @@ func GetStageByName(stages []qovery.DeploymentStageResponse, stageName string) (
 		}
 	}
 
-	return nil, errors.New("stage not found")
+	return nil, &utils.NotFoundError{Level: "stage", Name: stageName, Candidates: stageNames(stages)}
+}
+
+func stageNames(stages []qovery.DeploymentStageResponse) []string {
+	names := make([]string, len(stages))
+	for i, stage := range stages {
+		names[i] = stage.GetName()
+	}
+
+	return names
 }
 
 func init() {
 	environmentStageCmd.AddCommand(environmentStageDeleteCmd)
-	environmentStageDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	environmentStageDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	environmentStageDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
+	environmentStageDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	environmentStageDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	environmentStageDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
 	environmentStageDeleteCmd.Flags().StringVarP(&stageName, "name", "n", "", "Stage Name")
 
 	_ = environmentStageDeleteCmd.MarkFlagRequired("name")
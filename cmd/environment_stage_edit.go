@@ -5,45 +5,36 @@ import (
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/qovery/qovery-client-go"
 	"github.com/spf13/cobra"
-	"os"
 )
 
 var environmentStageEditCmd = &cobra.Command{
 	Use:   "edit",
 	Short: "Edit deployment stage",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, environmentId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		stages, _, err := client.DeploymentStageMainCallsApi.ListEnvironmentDeploymentStage(context.Background(), environmentId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		stage, err := GetStageByName(stages.GetResults(), stageName)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		req := qovery.DeploymentStageRequest{
@@ -60,20 +51,20 @@ var environmentStageEditCmd = &cobra.Command{
 		_, _, err = client.DeploymentStageMainCallsApi.EditDeploymentStage(context.Background(), stage.GetId()).DeploymentStageRequest(req).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println("Stage updated successfully")
+
+		return nil
 	},
 }
 
 func init() {
 	environmentStageCmd.AddCommand(environmentStageEditCmd)
-	environmentStageEditCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	environmentStageEditCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	environmentStageEditCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
+	environmentStageEditCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	environmentStageEditCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	environmentStageEditCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
 	environmentStageEditCmd.Flags().StringVarP(&stageName, "name", "n", "", "Stage Name")
 	environmentStageEditCmd.Flags().StringVarP(&newStageName, "new-name", "", "", "New Stage Name")
 	environmentStageEditCmd.Flags().StringVarP(&stageDescription, "new-description", "", "", "New Stage Description")
@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"github.com/pterm/pterm"
-	"os"
 	"strconv"
 
 	"github.com/qovery/qovery-cli/utils"
@@ -13,31 +12,25 @@ import (
 var environmentStageListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List deployment stages",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, environmentId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		stages, _, err := client.DeploymentStageMainCallsApi.ListEnvironmentDeploymentStage(context.Background(), environmentId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		for _, stage := range stages.GetResults() {
@@ -65,17 +58,17 @@ var environmentStageListCmd = &cobra.Command{
 			utils.Println("")
 
 			if err != nil {
-				utils.PrintlnError(err)
-				os.Exit(1)
-				panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+				return err
 			}
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	environmentStageCmd.AddCommand(environmentStageListCmd)
-	environmentStageListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	environmentStageListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	environmentStageListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
+	environmentStageListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	environmentStageListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	environmentStageListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
 }
@@ -6,37 +6,30 @@ import (
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/qovery/qovery-client-go"
 	"github.com/spf13/cobra"
-	"os"
 )
 
 var environmentStageMoveCmd = &cobra.Command{
 	Use:   "move",
 	Short: "Move service into deployment stage",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, environmentId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		stages, _, err := client.DeploymentStageMainCallsApi.ListEnvironmentDeploymentStage(context.Background(), environmentId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		var service *qovery.DeploymentStageServiceResponse
@@ -49,17 +42,13 @@ var environmentStageMoveCmd = &cobra.Command{
 		}
 
 		if service == nil {
-			utils.PrintlnError(errors.New("service not found"))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return &utils.NotFoundError{Level: "service", Name: serviceName, Parent: "the deployment stages"}
 		}
 
 		stage, err := GetStageByName(stages.GetResults(), stageName)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		req := qovery.DeploymentStageRequest{
@@ -76,17 +65,21 @@ var environmentStageMoveCmd = &cobra.Command{
 		_, _, err = client.DeploymentStageMainCallsApi.AttachServiceToDeploymentStage(context.Background(), stage.GetId(), service.GetServiceId()).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println("Application moved into stage \"" + stageName + "\"")
+
+		return nil
 	},
 }
 
 func getServiceByName(client *qovery.APIClient, services []qovery.DeploymentStageServiceResponse, name string) (*qovery.DeploymentStageServiceResponse, error) {
 	for _, service := range services {
+		if service.GetServiceId() == name {
+			return &service, nil
+		}
+
 		switch service.GetServiceType() {
 		case "APPLICATION":
 			application, _, err := client.ApplicationMainCallsApi.GetApplication(context.Background(), service.GetServiceId()).Execute()
@@ -129,15 +122,15 @@ func getServiceByName(client *qovery.APIClient, services []qovery.DeploymentStag
 		}
 	}
 
-	return nil, errors.New("service not found")
+	return nil, &utils.NotFoundError{Level: "service", Name: name}
 }
 
 func init() {
 	environmentStageCmd.AddCommand(environmentStageMoveCmd)
-	environmentStageMoveCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	environmentStageMoveCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	environmentStageMoveCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	environmentStageMoveCmd.Flags().StringVarP(&serviceName, "name", "n", "", "Service Name")
+	environmentStageMoveCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	environmentStageMoveCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	environmentStageMoveCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	environmentStageMoveCmd.Flags().StringVarP(&serviceName, "name", "n", "", "Service Name or ID")
 	environmentStageMoveCmd.Flags().StringVarP(&stageName, "stage", "s", "", "Target Stage Name")
 
 	_ = environmentStageMoveCmd.MarkFlagRequired("name")
@@ -2,8 +2,6 @@ package cmd
 
 import (
 	"context"
-	"fmt"
-	"os"
 	"strings"
 
 	"github.com/qovery/qovery-cli/utils"
@@ -14,40 +12,31 @@ import (
 var environmentUpdateCmd = &cobra.Command{
 	Use:   "update",
 	Short: "Update an environment",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
-		_, projectId, _, err := getContextResourcesId(client)
+		_, projectId, err := getProjectResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		environments, _, err := client.EnvironmentsApi.ListEnvironment(context.Background(), projectId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		env := utils.FindByEnvironmentName(environments.GetResults(), environmentName)
 
 		if env == nil {
-			utils.PrintlnError(fmt.Errorf("environment %s not found", environmentName))
-			utils.PrintlnInfo("You can list all environments with: qovery environment list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "environment", Name: environmentName, Candidates: utils.EnvironmentNames(environments.GetResults())}, "You can list all environments with: qovery environment list")
 		}
 
 		m := getEnvironmentType(string(env.Mode))
@@ -68,12 +57,12 @@ var environmentUpdateCmd = &cobra.Command{
 		_, _, err = client.EnvironmentMainCallsApi.EditEnvironment(context.Background(), env.Id).EnvironmentEditRequest(req).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println("Environment is updated!")
+
+		return nil
 	},
 }
 
@@ -92,9 +81,9 @@ func getEnvironmentType(environmentType string) qovery.CreateEnvironmentModeEnum
 
 func init() {
 	environmentCmd.AddCommand(environmentUpdateCmd)
-	environmentUpdateCmd.Flags().StringVarP(&organizationName, "organization", "o", "", "Organization Name")
-	environmentUpdateCmd.Flags().StringVarP(&projectName, "project", "p", "", "Project Name")
-	environmentUpdateCmd.Flags().StringVarP(&environmentName, "environment", "e", "", "Environment Name")
+	environmentUpdateCmd.Flags().StringVarP(&organizationName, "organization", "o", "", "Organization Name or ID")
+	environmentUpdateCmd.Flags().StringVarP(&projectName, "project", "p", "", "Project Name or ID")
+	environmentUpdateCmd.Flags().StringVarP(&environmentName, "environment", "e", "", "Environment Name or ID")
 	environmentUpdateCmd.Flags().StringVarP(&newEnvironmentName, "name", "", "", "New Environment Name")
 	environmentUpdateCmd.Flags().StringVarP(&environmentType, "type", "", "", "Change Environment Type (DEVELOPMENT|STAGING|PRODUCTION)")
 }
@@ -5,7 +5,6 @@ import (
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/qovery/qovery-client-go"
 	"github.com/spf13/cobra"
-	"os"
 )
 
 var lifecycleName string
@@ -17,13 +16,14 @@ var targetLifecycleName string
 var lifecycleCmd = &cobra.Command{
 	Use:   "lifecycle",
 	Short: "Manage lifecycle jobs",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		if len(args) == 0 {
-			_ = cmd.Help()
-			os.Exit(0)
+			return cmd.Help()
 		}
+
+		return nil
 	},
 }
 
@@ -3,8 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"io"
-	"os"
 
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
@@ -15,56 +13,43 @@ import (
 var lifecycleCloneCmd = &cobra.Command{
 	Use:   "clone",
 	Short: "Clone a lifecycle job",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		jobs, _, err := client.JobsApi.ListJobs(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		job := utils.FindByJobName(jobs.GetResults(), lifecycleName)
 
 		if job == nil {
-			utils.PrintlnError(fmt.Errorf("job %s not found", lifecycleName))
-			utils.PrintlnInfo("You can list all jobs with: qovery job list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "job", Name: lifecycleName, Candidates: utils.JobNames(jobs.GetResults())}, "You can list all jobs with: qovery job list")
 		}
 
 		sourceEnvironment, _, err := client.EnvironmentMainCallsApi.GetEnvironment(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		environments, _, err := client.EnvironmentsApi.ListEnvironment(context.Background(), sourceEnvironment.Project.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if targetEnvironmentName == "" {
@@ -75,9 +60,7 @@ var lifecycleCloneCmd = &cobra.Command{
 		targetEnvironment := utils.FindByEnvironmentName(environments.GetResults(), targetEnvironmentName)
 
 		if targetEnvironment == nil {
-			utils.PrintlnError(fmt.Errorf("environment %s not found", targetEnvironmentName))
-			utils.PrintlnInfo("You can list all environments with: qovery environment list")
-			os.Exit(1)
+			return utils.WithHint(&utils.NotFoundError{Level: "environment", Name: targetEnvironmentName, Candidates: utils.EnvironmentNames(environments.GetResults())}, "You can list all environments with: qovery environment list")
 		}
 
 		if targetLifecycleName == "" {
@@ -138,58 +121,49 @@ var lifecycleCloneCmd = &cobra.Command{
 			Schedule:           &schedule,
 		}
 
-		createdService, res, err := client.JobsApi.CreateJob(context.Background(), targetEnvironment.Id).JobRequest(req).Execute()
+		createdService, _, err := client.JobsApi.CreateJob(context.Background(), targetEnvironment.Id).JobRequest(req).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-
-			bodyBytes, err := io.ReadAll(res.Body)
-			if err != nil {
-				return
-			}
-
-			utils.PrintlnError(fmt.Errorf("unable to clone job %s", string(bodyBytes)))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			// the body of the response, telling why the clone was rejected, is printed along the error
+			return err
 		}
 
-		deploymentStageId := utils.GetDeploymentStageId(client, job.Id)
+		deploymentStageId, err := utils.GetDeploymentStageId(client, job.Id)
+		if err != nil {
+			return err
+		}
 
 		_, _, err = client.DeploymentStageMainCallsApi.AttachServiceToDeploymentStage(context.Background(), deploymentStageId, createdService.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		// clone advanced settings
 		settings, _, err := client.JobConfigurationApi.GetJobAdvancedSettings(context.Background(), job.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		_, _, err = client.JobConfigurationApi.EditJobAdvancedSettings(context.Background(), createdService.Id).JobAdvancedSettings(*settings).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Lifecycle %s cloned!", pterm.FgBlue.Sprintf(lifecycleName)))
+
+		return nil
 	},
 }
 
 func init() {
 	lifecycleCmd.AddCommand(lifecycleCloneCmd)
-	lifecycleCloneCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	lifecycleCloneCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	lifecycleCloneCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	lifecycleCloneCmd.Flags().StringVarP(&lifecycleName, "lifecycle", "n", "", "Lifecycle Name")
+	lifecycleCloneCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	lifecycleCloneCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	lifecycleCloneCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	lifecycleCloneCmd.Flags().StringVarP(&lifecycleName, "lifecycle", "n", "", "Lifecycle Name or ID")
 	lifecycleCloneCmd.Flags().StringVarP(&targetEnvironmentName, "target-environment", "", "", "Target Environment Name")
 	lifecycleCloneCmd.Flags().StringVarP(&targetLifecycleName, "target-lifecycle-name", "", "", "Target Lifecycle Name")
 
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"github.com/pterm/pterm"
-	"os"
 
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
@@ -13,71 +12,60 @@ import (
 var lifecycleDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete a lifecycle job",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if !utils.IsEnvironmentInATerminalState(envId, client) {
-			utils.PrintlnError(fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
-				"for the end of the current operation to run your command. Try again in a few moment", envId))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
+				"for the end of the current operation to run your command. Try again in a few moment", envId)
 		}
 
 		lifecycles, err := ListLifecycleJobs(envId, client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		lifecycle := utils.FindByJobName(lifecycles, lifecycleName)
 
 		if lifecycle == nil {
-			utils.PrintlnError(fmt.Errorf("lifecycle %s not found", lifecycleName))
-			utils.PrintlnInfo("You can list all lifecycle jobs with: qovery lifecycle list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "lifecycle", Name: lifecycleName, Candidates: utils.JobNames(lifecycles)}, "You can list all lifecycle jobs with: qovery lifecycle list")
 		}
 
 		_, err = client.JobMainCallsApi.DeleteJob(context.Background(), lifecycle.Id).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println(fmt.Sprintf("Deleting lifecycle job %s in progress..", pterm.FgBlue.Sprintf(lifecycleName)))
 
 		if watchFlag {
-			utils.WatchJob(lifecycle.Id, envId, client)
+			return utils.WatchJob(lifecycle.Id, envId, client)
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	lifecycleCmd.AddCommand(lifecycleDeleteCmd)
-	lifecycleDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	lifecycleDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	lifecycleDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	lifecycleDeleteCmd.Flags().StringVarP(&lifecycleName, "lifecycle", "n", "", "Lifecycle Job Name")
+	lifecycleDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	lifecycleDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	lifecycleDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	lifecycleDeleteCmd.Flags().StringVarP(&lifecycleName, "lifecycle", "n", "", "Lifecycle Job Name or ID")
 	lifecycleDeleteCmd.Flags().BoolVarP(&watchFlag, "watch", "w", false, "Watch lifecycle job status until it's ready or an error occurs")
 
 	_ = lifecycleDeleteCmd.MarkFlagRequired("lifecycle")
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"github.com/pterm/pterm"
-	"os"
 
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/qovery/qovery-client-go"
@@ -14,48 +13,36 @@ import (
 var lifecycleDeployCmd = &cobra.Command{
 	Use:   "deploy",
 	Short: "Deploy a lifecycle job",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if lifecycleName == "" && lifecycleNames == "" {
-			utils.PrintlnError(fmt.Errorf("use neither --lifecycle \"<container name>\" nor --lifecycles \"<container1 name>, <container2 name>\""))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("use neither --lifecycle \"<container name>\" nor --lifecycles \"<container1 name>, <container2 name>\"")
 		}
 
 		if lifecycleName != "" && lifecycleNames != "" {
-			utils.PrintlnError(fmt.Errorf("you can't use --lifecycle and --lifecycles at the same time"))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("you can't use --lifecycle and --lifecycles at the same time")
 		}
 
 		if lifecycleTag != "" && lifecycleCommitId != "" {
-			utils.PrintlnError(fmt.Errorf("you can't use --tag and --commit-id at the same time"))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("you can't use --tag and --commit-id at the same time")
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		if !utils.IsEnvironmentInATerminalState(envId, client) {
-			utils.PrintlnError(fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
-				"for the end of the current operation to run your command. Try again in a few moment", envId))
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("environment id '%s' is not in a terminal state. The request is not queued and you must wait "+
+				"for the end of the current operation to run your command. Try again in a few moment", envId)
 		}
 
 		if lifecycleNames != "" {
@@ -63,35 +50,28 @@ var lifecycleDeployCmd = &cobra.Command{
 			err := utils.DeployJobs(client, envId, lifecycleNames, lifecycleCommitId, lifecycleTag)
 
 			if err != nil {
-				utils.PrintlnError(err)
-				os.Exit(1)
-				panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+				return err
 			}
 
 			utils.Println(fmt.Sprintf("Deploying lifecycles %s in progress..", pterm.FgBlue.Sprintf(lifecycleNames)))
 
 			if watchFlag {
-				utils.WatchEnvironment(envId, "unused", client)
+				return utils.WatchEnvironment(envId, "unused", client)
 			}
 
-			return
+			return nil
 		}
 
 		lifecycles, err := ListLifecycleJobs(envId, client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		lifecycle := utils.FindByJobName(lifecycles, lifecycleName)
 
 		if lifecycle == nil {
-			utils.PrintlnError(fmt.Errorf("lifecycle %s not found", lifecycleName))
-			utils.PrintlnInfo("You can list all lifecycle jobs with: qovery lifecycle list")
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return utils.WithHint(&utils.NotFoundError{Level: "lifecycle", Name: lifecycleName, Candidates: utils.JobNames(lifecycles)}, "You can list all lifecycle jobs with: qovery lifecycle list")
 		}
 
 		docker := lifecycle.Source.Docker.Get()
@@ -120,25 +100,26 @@ var lifecycleDeployCmd = &cobra.Command{
 		_, _, err = client.JobActionsApi.DeployJob(context.Background(), lifecycle.Id).JobDeployRequest(req).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		utils.Println("Lifecycle job is deploying!")
 
 		if watchFlag {
-			utils.WatchJob(lifecycle.Id, envId, client)
+			return utils.WatchJob(lifecycle.Id, envId, client)
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	lifecycleCmd.AddCommand(lifecycleDeployCmd)
-	lifecycleDeployCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	lifecycleDeployCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	lifecycleDeployCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
-	lifecycleDeployCmd.Flags().StringVarP(&lifecycleName, "lifecycle", "n", "", "Lifecycle Job Name")
+	lifecycleDeployCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	lifecycleDeployCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	lifecycleDeployCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	lifecycleDeployCmd.Flags().StringVarP(&lifecycleName, "lifecycle", "n", "", "Lifecycle Job Name or ID")
+	lifecycleDeployCmd.Flags().StringVarP(&consoleUrl, "url", "", "", "Qovery console URL of the resource, instead of its names")
 	lifecycleDeployCmd.Flags().StringVarP(&lifecycleNames, "lifecycles", "", "", "Lifecycle Job Names")
 	lifecycleDeployCmd.Flags().StringVarP(&lifecycleCommitId, "commit-id", "c", "", "Lifecycle Commit ID")
 	lifecycleDeployCmd.Flags().StringVarP(&lifecycleTag, "tag", "t", "", "Lifecycle Tag")
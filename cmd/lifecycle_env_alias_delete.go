@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var lifecycleEnvAliasDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete lifecycle environment variable or secret alias",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		_, projectId, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		lifecycles, _, err := client.JobsApi.ListJobs(context.Background(), envId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		lifecycle := utils.FindByJobName(lifecycles.GetResults(), lifecycleName)
+
+		if lifecycle == nil {
+			return utils.WithHint(&utils.NotFoundError{Level: "lifecycle", Name: lifecycleName, Candidates: utils.JobNames(lifecycles.GetResults())}, "You can list all lifecycles with: qovery lifecycle list")
+		}
+
+		err = utils.DeleteAliasByKey(client, projectId, envId, lifecycle.Id, utils.JobType, utils.Alias)
+
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("Alias %s has been deleted", pterm.FgBlue.Sprintf(utils.Alias)))
+
+		return nil
+	},
+}
+
+func init() {
+	lifecycleEnvAliasCmd.AddCommand(lifecycleEnvAliasDeleteCmd)
+	lifecycleEnvAliasDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	lifecycleEnvAliasDeleteCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	lifecycleEnvAliasDeleteCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	lifecycleEnvAliasDeleteCmd.Flags().StringVarP(&lifecycleName, "lifecycle", "n", "", "Lifecycle Name or ID")
+	lifecycleEnvAliasDeleteCmd.Flags().StringVarP(&utils.Alias, "alias", "", "", "Environment variable or secret alias")
+
+	_ = lifecycleEnvAliasDeleteCmd.MarkFlagRequired("alias")
+	_ = lifecycleEnvAliasDeleteCmd.MarkFlagRequired("lifecycle")
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var lifecycleEnvAliasListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List lifecycle environment variable and secret aliases",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+
+		_, _, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		lifecycles, _, err := client.JobsApi.ListJobs(context.Background(), envId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		lifecycle := utils.FindByJobName(lifecycles.GetResults(), lifecycleName)
+
+		if lifecycle == nil {
+			return utils.WithHint(&utils.NotFoundError{Level: "lifecycle", Name: lifecycleName, Candidates: utils.JobNames(lifecycles.GetResults())}, "You can list all lifecycles with: qovery lifecycle list")
+		}
+
+		envVars, _, err := client.JobEnvironmentVariableApi.ListJobEnvironmentVariable(
+			context.Background(),
+			lifecycle.Id,
+		).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		secrets, _, err := client.JobSecretApi.ListJobSecrets(
+			context.Background(),
+			lifecycle.Id,
+		).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		envVarLines := utils.NewEnvVarLines()
+
+		for _, envVar := range envVars.GetResults() {
+			if envVar.AliasedVariable != nil {
+				envVarLines.Add(utils.FromEnvironmentVariableToEnvVarLineOutput(envVar))
+			}
+		}
+
+		for _, secret := range secrets.GetResults() {
+			if secret.AliasedSecret != nil {
+				envVarLines.Add(utils.FromSecretToEnvVarLineOutput(secret))
+			}
+		}
+
+		err = utils.PrintTable(envVarLines.Header(false), envVarLines.Lines(utils.ShowValues, false))
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	lifecycleEnvAliasCmd.AddCommand(lifecycleEnvAliasListCmd)
+	lifecycleEnvAliasListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	lifecycleEnvAliasListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	lifecycleEnvAliasListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	lifecycleEnvAliasListCmd.Flags().StringVarP(&lifecycleName, "lifecycle", "n", "", "Lifecycle Name or ID")
+	lifecycleEnvAliasListCmd.Flags().BoolVarP(&utils.ShowValues, "show-values", "", false, "Show env var values")
+
+	_ = lifecycleEnvAliasListCmd.MarkFlagRequired("lifecycle")
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var lifecycleEnvUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update lifecycle environment variable or secret value",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		_, projectId, envId, err := getContextResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		lifecycles, _, err := client.JobsApi.ListJobs(context.Background(), envId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		lifecycle := utils.FindByJobName(lifecycles.GetResults(), lifecycleName)
+
+		if lifecycle == nil {
+			return utils.WithHint(&utils.NotFoundError{Level: "lifecycle", Name: lifecycleName, Candidates: utils.JobNames(lifecycles.GetResults())}, "You can list all lifecycles with: qovery lifecycle list")
+		}
+
+		value, err := utils.ReadValue(utils.Value, utils.ValueFromFile, utils.ValueFromStdin)
+
+		if err != nil {
+			return err
+		}
+
+		err = utils.UpdateByKey(client, projectId, envId, lifecycle.Id, utils.JobType, utils.Key, value)
+
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("Variable %s has been updated", pterm.FgBlue.Sprintf(utils.Key)))
+
+		return nil
+	},
+}
+
+func init() {
+	lifecycleEnvCmd.AddCommand(lifecycleEnvUpdateCmd)
+	lifecycleEnvUpdateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	lifecycleEnvUpdateCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	lifecycleEnvUpdateCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	lifecycleEnvUpdateCmd.Flags().StringVarP(&lifecycleName, "lifecycle", "n", "", "Lifecycle Name or ID")
+	lifecycleEnvUpdateCmd.Flags().StringVarP(&utils.Key, "key", "k", "", "Environment variable or secret key")
+	lifecycleEnvUpdateCmd.Flags().StringVarP(&utils.Value, "value", "v", "", "Environment variable or secret value")
+	lifecycleEnvUpdateCmd.Flags().StringVarP(&utils.ValueFromFile, "value-from-file", "", "", "Read the environment variable or secret value from a file")
+	lifecycleEnvUpdateCmd.Flags().BoolVarP(&utils.ValueFromStdin, "value-from-stdin", "", false, "Read the environment variable or secret value from stdin")
+
+	_ = lifecycleEnvUpdateCmd.MarkFlagRequired("key")
+	_ = lifecycleEnvUpdateCmd.MarkFlagRequired("lifecycle")
+}
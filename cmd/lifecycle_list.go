@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"os"
 
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
@@ -11,14 +10,12 @@ import (
 var lifecycleListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List lifecycle jobs",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
@@ -26,25 +23,19 @@ var lifecycleListCmd = &cobra.Command{
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		lifecycles, err := ListLifecycleJobs(envId, client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		statuses, _, err := client.EnvironmentMainCallsApi.GetEnvironmentStatuses(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		var data [][]string
@@ -57,16 +48,16 @@ var lifecycleListCmd = &cobra.Command{
 		err = utils.PrintTable([]string{"Name", "Type", "Status", "Last Update"}, data)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
+
+		return nil
 	},
 }
 
 func init() {
 	lifecycleCmd.AddCommand(lifecycleListCmd)
-	lifecycleListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	lifecycleListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	lifecycleListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
+	lifecycleListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	lifecycleListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	lifecycleListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
 }
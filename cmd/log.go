@@ -16,9 +16,12 @@ var follow bool
 var logCmd = &cobra.Command{
 	Use:   "log",
 	Short: "Print your application logs",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
-		var logs = getLogs()
+		logs, err := getLogs()
+		if err != nil {
+			return err
+		}
 
 		table := setupTable(true)
 		table.AppendBulk(logs)
@@ -26,7 +29,7 @@ var logCmd = &cobra.Command{
 
 		if len(logs) <= 0 {
 			utils.PrintlnInfo("No logs found. ")
-			os.Exit(0)
+			return nil
 		}
 
 		var lastRenderedLogs = logs
@@ -36,7 +39,10 @@ var logCmd = &cobra.Command{
 
 			lastLogDateString := lastRenderedLogs[len(lastRenderedLogs)-1][0]
 			lastLogDate, _ := time.Parse(time.StampMicro, lastLogDateString)
-			var newLogs = getLogs()
+			newLogs, err := getLogs()
+			if err != nil {
+				return err
+			}
 
 			if len(newLogs) > 0 {
 				for _, newLog := range newLogs {
@@ -51,20 +57,20 @@ var logCmd = &cobra.Command{
 
 			time.Sleep(time.Second * 5)
 		}
+
+		return nil
 	},
 }
 
-func getLogs() [][]string {
+func getLogs() ([][]string, error) {
 	tokenType, token, err := utils.GetAccessToken()
 	if err != nil {
-		utils.PrintlnError(err)
-		os.Exit(0)
+		return nil, err
 	}
 
 	service, err := utils.CurrentService()
 	if err != nil {
-		utils.PrintlnError(err)
-		os.Exit(0)
+		return nil, err
 	}
 
 	client := utils.GetQoveryClient(tokenType, token)
@@ -74,11 +80,10 @@ func getLogs() [][]string {
 	case utils.ApplicationType:
 		logs, res, err := client.ApplicationLogsApi.ListApplicationLog(context.Background(), string(service.ID)).Execute()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(0)
+			return nil, err
 		}
 		if res.StatusCode >= 400 {
-			utils.PrintlnError(errors.New("Received " + res.Status + " response while getting application logs "))
+			return nil, errors.New("Received " + res.Status + " response while getting application logs ")
 		}
 
 		for _, log := range logs.GetResults() {
@@ -87,11 +92,10 @@ func getLogs() [][]string {
 	case utils.ContainerType:
 		logs, res, err := client.ContainerLogsApi.ListContainerLog(context.Background(), string(service.ID)).Execute()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(0)
+			return nil, err
 		}
 		if res.StatusCode >= 400 {
-			utils.PrintlnError(errors.New("Received " + res.Status + " response while getting container logs"))
+			return nil, errors.New("Received " + res.Status + " response while getting container logs")
 		}
 
 		for _, log := range logs.GetResults() {
@@ -99,7 +103,7 @@ func getLogs() [][]string {
 		}
 	}
 
-	return logRows
+	return logRows, nil
 }
 
 func setupTable(header bool) *tablewriter.Table {
@@ -129,4 +133,5 @@ func setupTable(header bool) *tablewriter.Table {
 func init() {
 	rootCmd.AddCommand(logCmd)
 	logCmd.Flags().BoolVarP(&follow, "follow", "f", false, "Follow application logs")
+	logCmd.Flags().StringVarP(&consoleUrl, "url", "", "", "Qovery console URL of the service, instead of the current context")
 }
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/qovery/qovery-cli/pkg"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/qovery/qovery-client-go"
+	"github.com/spf13/cobra"
+)
+
+var portForwardCmd = &cobra.Command{
+	Use:   "port-forward [LOCAL_PORT:]REMOTE_PORT...",
+	Short: "Forward local ports to a service or a database",
+	Example: `  qovery port-forward --database pg-main 5432:5432
+  qovery port-forward --application api 8080:80`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+
+		request, err := portForwardRequestFromFlags(client)
+		if err != nil {
+			return err
+		}
+
+		var credentials *qovery.Credentials
+		var database *qovery.Database
+		if request.ServiceType == utils.DatabaseType {
+			database, _, err = client.DatabaseMainCallsApi.GetDatabase(context.Background(), string(request.ServiceID)).Execute()
+			if err == nil {
+				credentials, _, err = client.DatabaseMainCallsApi.GetDatabaseMasterCredentials(context.Background(), string(request.ServiceID)).Execute()
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		// buffered so the forwards still running when the first one fails don't block on it
+		errs := make(chan error, len(args))
+		for _, mapping := range args {
+			localPort, remotePort, err := pkg.ParsePortMapping(mapping)
+			if err != nil {
+				return err
+			}
+
+			forward := &pkg.PortForwardRequest{ShellRequest: *request, LocalPort: localPort, RemotePort: remotePort}
+			go func() {
+				errs <- pkg.ExecPortForward(forward, func(addr *net.TCPAddr) {
+					utils.Println(fmt.Sprintf("Forwarding %s -> %d", addr, forward.RemotePort))
+					if database != nil {
+						utils.Println(fmt.Sprintf("  %s", databaseConnectionString(database.Type, credentials, addr.Port)))
+					}
+				})
+			}()
+		}
+
+		// forwarding runs until interrupted, or until a local port can't be listened on
+		return <-errs
+	},
+}
+
+func portForwardRequestFromFlags(client *qovery.APIClient) (*pkg.ShellRequest, error) {
+	currentContext, err := utils.EffectiveContext()
+	if err != nil {
+		return nil, err
+	}
+
+	var name string
+	var serviceType utils.ServiceType
+	switch {
+	case applicationName != "":
+		name, serviceType = applicationName, utils.ApplicationType
+	case containerName != "":
+		name, serviceType = containerName, utils.ContainerType
+	case databaseName != "":
+		name, serviceType = databaseName, utils.DatabaseType
+	}
+
+	currentContext, err = utils.ResolveContextSelection(currentContext, utils.ContextSelection{
+		Organization: organizationName,
+		Project:      projectName,
+		Environment:  environmentName,
+		Service:      name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if currentContext.ServiceId == "" {
+		return nil, fmt.Errorf("one of --application, --container or --database is required")
+	}
+
+	if serviceType != "" && currentContext.ServiceType != serviceType {
+		return nil, fmt.Errorf("%s is a %s, not a %s", name, currentContext.ServiceType, serviceType)
+	}
+
+	environment, _, err := client.EnvironmentMainCallsApi.GetEnvironment(context.Background(), string(currentContext.EnvironmentId)).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pkg.ShellRequest{
+		ServiceID:      currentContext.ServiceId,
+		ServiceType:    currentContext.ServiceType,
+		ProjectID:      currentContext.ProjectId,
+		OrganizationID: currentContext.OrganizationId,
+		EnvironmentID:  currentContext.EnvironmentId,
+		ClusterID:      utils.Id(environment.ClusterId),
+		PodName:        podName,
+	}, nil
+}
+
+// databaseConnectionString targets the forwarded local port with the master credentials of the database
+func databaseConnectionString(databaseType qovery.DatabaseTypeEnum, credentials *qovery.Credentials, localPort int) string {
+	userInfo := url.UserPassword(credentials.Login, credentials.Password)
+	host := fmt.Sprintf("localhost:%d", localPort)
+
+	switch databaseType {
+	case qovery.DATABASETYPEENUM_POSTGRESQL:
+		return (&url.URL{Scheme: "postgresql", User: userInfo, Host: host, Path: "/postgres"}).String()
+	case qovery.DATABASETYPEENUM_MYSQL:
+		return (&url.URL{Scheme: "mysql", User: userInfo, Host: host}).String()
+	case qovery.DATABASETYPEENUM_MONGODB:
+		return (&url.URL{Scheme: "mongodb", User: userInfo, Host: host, RawQuery: "directConnection=true"}).String()
+	case qovery.DATABASETYPEENUM_REDIS:
+		return (&url.URL{Scheme: "redis", User: url.UserPassword("", credentials.Password), Host: host}).String()
+	default:
+		return host
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(portForwardCmd)
+	portForwardCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	portForwardCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	portForwardCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	portForwardCmd.Flags().StringVarP(&applicationName, "application", "", "", "Application Name or ID")
+	portForwardCmd.Flags().StringVarP(&containerName, "container", "", "", "Container Name or ID")
+	portForwardCmd.Flags().StringVarP(&databaseName, "database", "", "", "Database Name or ID")
+	portForwardCmd.Flags().StringVarP(&podName, "pod", "", "", "Instance (pod) to forward to, picked by the server when empty")
+}
@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var projectEnvCmd = &cobra.Command{
+	Use:   "env",
+	Short: "Manage project environment variables and secrets",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		if len(args) == 0 {
+			return cmd.Help()
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	projectCmd.AddCommand(projectEnvCmd)
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var projectEnvCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create project environment variable or secret",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		_, projectId, err := getProjectResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		if utils.IsSecret {
+			err = utils.CreateSecret(client, projectId, "", "", utils.Key, utils.Value, "PROJECT")
+
+			if err != nil {
+				return err
+			}
+
+			utils.Println(fmt.Sprintf("Secret %s has been created", pterm.FgBlue.Sprintf(utils.Key)))
+			return nil
+		}
+
+		err = utils.CreateEnvironmentVariable(client, projectId, "", "", utils.Key, utils.Value, "PROJECT")
+
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("Environment variable %s has been created", pterm.FgBlue.Sprintf(utils.Key)))
+
+		return nil
+	},
+}
+
+func init() {
+	projectEnvCmd.AddCommand(projectEnvCreateCmd)
+	projectEnvCreateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	projectEnvCreateCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	projectEnvCreateCmd.Flags().StringVarP(&utils.Key, "key", "k", "", "Environment variable or secret key")
+	projectEnvCreateCmd.Flags().StringVarP(&utils.Value, "value", "v", "", "Environment variable or secret value")
+	projectEnvCreateCmd.Flags().BoolVarP(&utils.IsSecret, "secret", "", false, "This environment variable is a secret")
+
+	_ = projectEnvCreateCmd.MarkFlagRequired("key")
+	_ = projectEnvCreateCmd.MarkFlagRequired("value")
+}
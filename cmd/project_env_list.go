@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var projectEnvListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List project environment variables",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		_, projectId, err := getProjectResourcesId(client)
+
+		if err != nil {
+			return err
+		}
+
+		if utils.ShowTree {
+			if utils.Key == "" {
+				return fmt.Errorf("--key is required to display the tree of a variable")
+			}
+
+			tree, err := utils.BuildEnvVarTree(client, projectId, "", utils.Key, "PROJECT")
+
+			if err != nil {
+				return err
+			}
+
+			err = pterm.DefaultTree.WithRoot(*tree).Render()
+
+			if err != nil {
+				return err
+			}
+
+			return nil
+		}
+
+		envVars, err := utils.ListEnvironmentVariablesByScope(client, projectId, "", "PROJECT")
+
+		if err != nil {
+			return err
+		}
+
+		secrets, err := utils.ListSecretsByScope(client, projectId, "", "PROJECT")
+
+		if err != nil {
+			return err
+		}
+
+		envVarLines := utils.NewEnvVarLines()
+
+		for _, envVar := range envVars {
+			envVarLines.Add(utils.FromEnvironmentVariableToEnvVarLineOutput(envVar))
+		}
+
+		for _, secret := range secrets {
+			envVarLines.Add(utils.FromSecretToEnvVarLineOutput(secret))
+		}
+
+		err = utils.PrintTable(envVarLines.Header(utils.PrettyPrint), envVarLines.Lines(utils.ShowValues, utils.PrettyPrint))
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	projectEnvCmd.AddCommand(projectEnvListCmd)
+	projectEnvListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	projectEnvListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	projectEnvListCmd.Flags().StringVarP(&consoleUrl, "url", "", "", "Qovery console URL of the resource, instead of its names")
+	projectEnvListCmd.Flags().BoolVarP(&utils.ShowValues, "show-values", "", false, "Show env var values")
+	projectEnvListCmd.Flags().BoolVarP(&utils.PrettyPrint, "pretty-print", "", false, "Pretty print output")
+	projectEnvListCmd.Flags().BoolVarP(&utils.ShowTree, "tree", "", false, "Show the aliases and overrides depending on --key across environments and services")
+	projectEnvListCmd.Flags().StringVarP(&utils.Key, "key", "k", "", "Environment variable or secret key")
+}
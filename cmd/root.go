@@ -1,37 +1,98 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
 	"github.com/getsentry/sentry-go"
 	"github.com/qovery/qovery-cli/pkg"
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
 	"os"
+	"strings"
 	"time"
 )
 
 var rootCmd = &cobra.Command{
 	Use:   "qovery",
 	Short: "A Command-line Interface of the Qovery platform",
+	// errors are printed by Execute, which maps them to exit codes
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if initConfigErr != nil {
+			return initConfigErr
+		}
+		return applyConsoleUrl()
+	},
 }
 
+// initConfigErr is the failure to initialize the context, returned by the command to go through Execute
+var initConfigErr error
+
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
-		utils.PrintlnError(err)
-		os.Exit(0)
+	wrapArgsErrors(rootCmd)
+
+	cmd, err := rootCmd.ExecuteC()
+	if err != nil {
+		var usageErr *utils.UsageError
+		// the root command is not runnable, an error returned for it comes from parsing the command line,
+		// e.g. an unknown command. Cobra checks the required flags without going through the flag error function.
+		if !errors.As(err, &usageErr) && (cmd == rootCmd || strings.HasPrefix(err.Error(), "required flag(s)")) {
+			err = usageError(cmd, err)
+		}
+		utils.PrintlnCommandError(err)
+		os.Exit(utils.ExitCode(err))
 	}
 }
 
+func usageError(cmd *cobra.Command, err error) error {
+	return utils.WithHint(&utils.UsageError{Err: err}, fmt.Sprintf("Run '%s --help' for usage", cmd.CommandPath()))
+}
+
+// wrapArgsErrors turns the errors of the positional arguments validation into usage errors, like the flag errors.
+// Commands grouping subcommands and not taking arguments reject the unknown subcommands.
+func wrapArgsErrors(cmd *cobra.Command) {
+	args := cmd.Args
+	if args == nil && cmd.HasParent() && cmd.HasSubCommands() && !strings.Contains(cmd.Use, " ") {
+		args = unknownSubcommand
+	}
+
+	if args != nil {
+		cmd.Args = func(cmd *cobra.Command, positionalArgs []string) error {
+			err := args(cmd, positionalArgs)
+			if err != nil {
+				return usageError(cmd, err)
+			}
+			return nil
+		}
+	}
+
+	for _, subcommand := range cmd.Commands() {
+		wrapArgsErrors(subcommand)
+	}
+}
+
+func unknownSubcommand(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("unknown command %q for %q", args[0], cmd.CommandPath())
+	}
+	return nil
+}
+
 func init() {
 	cobra.OnInitialize(initConfig)
+	rootCmd.SetFlagErrorFunc(usageError)
+	rootCmd.PersistentFlags().StringVarP(&utils.ProfileName, "profile", "", "", "Context profile to use (default to QOVERY_PROFILE or the profile selected with 'qovery context use')")
 }
 
 func initConfig() {
-	if !utils.QoveryContextExists() {
-		err := utils.InitializeQoveryContext()
-		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(0)
-		}
+	exists, err := utils.QoveryContextExists()
+	if err == nil && !exists {
+		err = utils.InitializeQoveryContext()
+	}
+	if err != nil {
+		initConfigErr = err
+		return
 	}
 	initSentry()
 }
@@ -2,8 +2,6 @@ package cmd
 
 import (
 	"context"
-	"os"
-	"strings"
 
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/qovery/qovery-client-go"
@@ -18,63 +16,49 @@ var watchFlag bool
 var serviceListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List services",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
 		_, _, envId, err := getContextResourcesId(client)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		apps, _, err := client.ApplicationsApi.ListApplication(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		databases, _, err := client.DatabasesApi.ListDatabase(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		containers, _, err := client.ContainersApi.ListContainer(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		jobs, _, err := client.JobsApi.ListJobs(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		statuses, _, err := client.EnvironmentMainCallsApi.GetEnvironmentStatuses(context.Background(), envId).Execute()
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
 
 		var data [][]string
@@ -98,86 +82,25 @@ var serviceListCmd = &cobra.Command{
 		err = utils.PrintTable([]string{"Name", "Type", "Status"}, data)
 
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return err
 		}
+
+		return nil
 	},
 }
 
 func getContextResourcesId(qoveryAPIClient *qovery.APIClient) (string, string, string, error) {
-	var organizationId string
-	var projectId string
-	var environmentId string
-
-	if strings.TrimSpace(organizationName) == "" {
-		id, _, err := utils.CurrentOrganization()
-		if err != nil {
-			return "", "", "", err
-		}
-
-		organizationId = string(id)
-	} else {
-		organizations, _, err := qoveryAPIClient.OrganizationMainCallsApi.ListOrganization(context.Background()).Execute()
-
-		if err != nil {
-			return "", "", "", err
-		}
-
-		organization := utils.FindByOrganizationName(organizations.GetResults(), organizationName)
-		if organization != nil {
-			organizationId = organization.Id
-		}
-	}
-
-	if strings.TrimSpace(projectName) == "" {
-		id, _, err := utils.CurrentProject()
-		if err != nil {
-			return "", "", "", err
-		}
-
-		projectId = string(id)
-	} else {
-		// find project id by name
-		projects, _, err := qoveryAPIClient.ProjectsApi.ListProject(context.Background(), organizationId).Execute()
-
-		if err != nil {
-			return "", "", "", err
-		}
-
-		project := utils.FindByProjectName(projects.GetResults(), projectName)
-		if project != nil {
-			projectId = project.Id
-		}
-	}
-
-	if strings.TrimSpace(environmentName) == "" {
-		id, _, err := utils.CurrentEnvironment()
-		if err != nil {
-			return "", "", "", err
-		}
-
-		environmentId = string(id)
-	} else {
-		// find environment id by name
-		environments, _, err := qoveryAPIClient.EnvironmentsApi.ListEnvironment(context.Background(), projectId).Execute()
-
-		if err != nil {
-			return "", "", "", err
-		}
-
-		environment := utils.FindByEnvironmentName(environments.GetResults(), environmentName)
-		if environment != nil {
-			environmentId = environment.Id
-		}
-	}
+	return utils.ResolveResourcesId(qoveryAPIClient, organizationName, projectName, environmentName)
+}
 
-	return organizationId, projectId, environmentId, nil
+// getProjectResourcesId is getContextResourcesId for the commands without --environment
+func getProjectResourcesId(qoveryAPIClient *qovery.APIClient) (string, string, error) {
+	return utils.ResolveProjectId(qoveryAPIClient, organizationName, projectName)
 }
 
 func init() {
 	serviceCmd.AddCommand(serviceListCmd)
-	serviceListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name")
-	serviceListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name")
-	serviceListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name")
+	serviceListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	serviceListCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	serviceListCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
 }
@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
@@ -14,31 +16,159 @@ import (
 	"github.com/qovery/qovery-cli/utils"
 )
 
+var podName string
+var podContainerName string
+var shellRecordPath string
+
 var shellCmd = &cobra.Command{
-	Use:   "shell",
+	Use:   "shell [console url] [-- command...]",
 	Short: "Connect to an application container",
-	Run: func(cmd *cobra.Command, args []string) {
+	Long: `Open an interactive shell in a service container.
+
+When a command is given after --, it is run without a TTY instead: its stdout and stderr are streamed separately
+and its exit code becomes the exit code of qovery, e.g.
+
+  qovery shell --application api -- rails db:migrate`,
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
+		var command []string
+		if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+			command = args[dash:]
+			args = args[:dash]
+		}
+
 		var shellRequest *pkg.ShellRequest
 		var err error
-		if len(args) > 0 {
+		switch {
+		case len(args) > 0:
 			shellRequest, err = shellRequestWithApplicationUrl(args)
-		} else {
+		case applicationName != "" || serviceName != "":
+			shellRequest, err = shellRequestFromFlags()
+		case len(command) > 0:
+			// no prompt when running a command, the service must come from the context
+			shellRequest, err = shellRequestFromCompleteContext()
+		default:
 			shellRequest, err = shellRequestWithoutArg()
 		}
 		if err != nil {
-			utils.PrintlnError(err)
-			return
+			return err
 		}
 
-		pkg.ExecShell(shellRequest)
+		shellRequest.PodName = podName
+		shellRequest.ContainerName = podContainerName
+		hasInstances := shellRequest.ServiceType == utils.ApplicationType || shellRequest.ServiceType == utils.ContainerType || shellRequest.ServiceType == utils.JobType
+		if podName == "" && len(command) == 0 && hasInstances {
+			utils.PrintlnInfo("Select instance")
+			shellRequest.PodName, err = utils.SelectServiceInstance(shellRequest.ServiceID, shellRequest.ServiceType)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = setShellRecording(shellRequest, command)
+		if err != nil {
+			return err
+		}
+
+		if len(command) > 0 {
+			shellRequest.Command = command
+			exitCode, err := pkg.ExecCommand(shellRequest)
+			if err != nil {
+				return err
+			}
+
+			// the exit code of the remote command becomes the one of qovery
+			if exitCode != 0 {
+				return &utils.CommandExitError{Code: exitCode}
+			}
+			return nil
+		}
+
+		return pkg.ExecShell(shellRequest)
 	},
 }
 
+// setShellRecording records the session, interactive or running a command, to --record or to QOVERY_SHELL_RECORD_DIR.
+// QOVERY_SHELL_RECORD_DIR is meant to be set on the machines of an organization, it is only advisory: a user
+// can unset it, it is not an organization setting enforced by Qovery.
+func setShellRecording(shellRequest *pkg.ShellRequest, command []string) error {
+	recordPath := shellRecordPath
+	if recordPath == "" {
+		recordDir := os.Getenv("QOVERY_SHELL_RECORD_DIR")
+		if recordDir == "" {
+			return nil
+		}
+
+		err := os.MkdirAll(recordDir, 0700)
+		if err != nil {
+			return err
+		}
+
+		recordPath = filepath.Join(recordDir, fmt.Sprintf("%s-%s.cast", time.Now().UTC().Format("20060102T150405Z"), shellRequest.ServiceID))
+	}
+
+	currentContext, err := utils.CurrentContext()
+	if err != nil {
+		return err
+	}
+
+	shellRequest.RecordPath = recordPath
+	shellRequest.RecordingMetadata = pkg.RecordingMetadata{
+		User:           string(currentContext.User),
+		OrganizationID: string(shellRequest.OrganizationID),
+		ProjectID:      string(shellRequest.ProjectID),
+		EnvironmentID:  string(shellRequest.EnvironmentID),
+		ServiceID:      string(shellRequest.ServiceID),
+		PodName:        shellRequest.PodName,
+		Command:        strings.Join(command, " "),
+	}
+
+	// stdout carries the output of a command
+	_, _ = fmt.Fprintf(os.Stderr, "Info: This session is recorded to %s\n", recordPath)
+	return nil
+}
+
+func shellRequestFromFlags() (*pkg.ShellRequest, error) {
+	currentContext, err := utils.EffectiveContext()
+	if err != nil {
+		return nil, err
+	}
+
+	service := applicationName
+	if service == "" {
+		service = serviceName
+	}
+
+	currentContext, err = utils.ResolveContextSelection(currentContext, utils.ContextSelection{
+		Organization: organizationName,
+		Project:      projectName,
+		Environment:  environmentName,
+		Service:      service,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return shellRequestFromContext(currentContext)
+}
+
+func shellRequestFromCompleteContext() (*pkg.ShellRequest, error) {
+	currentContext, err := utils.EffectiveContext()
+	if err != nil {
+		return nil, err
+	}
+
+	if currentContext.ServiceId == "" || currentContext.EnvironmentId == "" {
+		return nil, errors.New("No service selected, use --application, --service or 'qovery context set'. ")
+	}
+
+	return shellRequestFromContext(currentContext)
+}
+
 func shellRequestWithoutArg() (*pkg.ShellRequest, error) {
 	useContext := false
-	currentContext, err := utils.CurrentContext()
+	currentContext, err := utils.EffectiveContext()
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +184,10 @@ func shellRequestWithoutArg() (*pkg.ShellRequest, error) {
 		fmt.Println()
 
 		utils.PrintlnInfo("Continue with shell command using this context ?")
-		useContext = utils.Validate("context")
+		useContext, err = utils.Validate("context")
+		if err != nil {
+			return nil, err
+		}
 		fmt.Println()
 	} else {
 		if err := utils.PrintlnContext(); err != nil {
@@ -108,15 +241,14 @@ func shellRequestFromSelect() (*pkg.ShellRequest, error) {
 		OrganizationID: orga.ID,
 		EnvironmentID:  env.ID,
 		ClusterID:      env.ClusterID,
+		ServiceType:    service.Type,
 	}, nil
 }
 
 func shellRequestFromContext(currentContext utils.QoveryContext) (*pkg.ShellRequest, error) {
 	tokenType, token, err := utils.GetAccessToken()
 	if err != nil {
-		utils.PrintlnError(err)
-		os.Exit(1)
-		panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+		return nil, err
 	}
 
 	client := utils.GetQoveryClient(tokenType, token)
@@ -135,104 +267,45 @@ func shellRequestFromContext(currentContext utils.QoveryContext) (*pkg.ShellRequ
 		OrganizationID: currentContext.OrganizationId,
 		EnvironmentID:  currentContext.EnvironmentId,
 		ClusterID:      utils.Id(e.ClusterId),
+		ServiceType:    currentContext.ServiceType,
 	}, nil
 }
 
 func shellRequestWithApplicationUrl(args []string) (*pkg.ShellRequest, error) {
-	var url = args[0]
-	url = strings.Replace(url, "https://console.qovery.com/platform/", "", 1)
-	url = strings.Replace(url, "https://new.console.qovery.com/", "", 1)
-	urlSplit := strings.Split(url, "/")
-
-	if len(urlSplit) < 8 {
-		return nil, errors.New("Wrong URL format: " + url)
-	}
-
-	var organizationId = urlSplit[1]
-	organization, err := utils.GetOrganizationById(organizationId)
-	if err != nil {
-		return nil, err
-	}
-
-	var projectId = urlSplit[3]
-	project, err := utils.GetProjectById(projectId)
-	if err != nil {
-		return nil, err
-	}
-
-	var environmentId = urlSplit[5]
-	environment, err := utils.GetEnvironmentById(environmentId)
+	currentContext, err := utils.ResolveConsoleUrl(args[0])
 	if err != nil {
 		return nil, err
 	}
 
-	environmentServices, err := utils.GetEnvironmentServicesById(environmentId)
-	if err != nil {
-		return nil, err
+	if currentContext.ServiceId == "" {
+		return nil, errors.New("The console URL must point to a service: " + args[0])
 	}
 
-	var service utils.Service
-	var serviceId = urlSplit[7]
-	for _, envService := range environmentServices {
-		if envService.ID == serviceId {
-			switch envService.Type {
-
-			case utils.ApplicationType:
-				applicationApi, err := utils.GetApplicationById(serviceId)
-				if err != nil {
-					return nil, err
-				}
-				service = utils.Service{
-					ID:   applicationApi.ID,
-					Name: applicationApi.Name,
-					Type: utils.ApplicationType,
-				}
-
-			case utils.ContainerType:
-				containerApi, err := utils.GetContainerById(serviceId)
-				if err != nil {
-					return nil, err
-				}
-				service = utils.Service{
-					ID:   containerApi.ID,
-					Name: containerApi.Name,
-					Type: utils.ContainerType,
-				}
-
-			case utils.JobType:
-				jobApi, err := utils.GetJobById(serviceId)
-				if err != nil {
-					return nil, err
-				}
-				service = utils.Service{
-					ID:   jobApi.ID,
-					Name: jobApi.Name,
-					Type: utils.JobType,
-				}
-
-			default:
-				return nil, errors.New("Service type `" + string(envService.Type) + "` is not supported for shell")
-			}
-		}
+	switch currentContext.ServiceType {
+	case utils.ApplicationType, utils.ContainerType, utils.JobType:
+	default:
+		return nil, errors.New("Service type `" + string(currentContext.ServiceType) + "` is not supported for shell")
 	}
 
 	_ = pterm.DefaultTable.WithData(pterm.TableData{
-		{"Organization", string(organization.Name)},
-		{"Project", string(project.Name)},
-		{"Environment", string(environment.Name)},
-		{"Service", string(service.Name)},
-		{"ServiceType", string(service.Type)},
+		{"Organization", string(currentContext.OrganizationName)},
+		{"Project", string(currentContext.ProjectName)},
+		{"Environment", string(currentContext.EnvironmentName)},
+		{"Service", string(currentContext.ServiceName)},
+		{"ServiceType", string(currentContext.ServiceType)},
 	}).Render()
 
-	return &pkg.ShellRequest{
-		OrganizationID: organization.ID,
-		ProjectID:      project.ID,
-		EnvironmentID:  environment.ID,
-		ServiceID:      service.ID,
-		ClusterID:      environment.ClusterID,
-	}, nil
+	return shellRequestFromContext(currentContext)
 }
 
 func init() {
 	rootCmd.AddCommand(shellCmd)
+	shellCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	shellCmd.Flags().StringVarP(&projectName, "project", "", "", "Project Name or ID")
+	shellCmd.Flags().StringVarP(&environmentName, "environment", "", "", "Environment Name or ID")
+	shellCmd.Flags().StringVarP(&applicationName, "application", "", "", "Application Name or ID")
+	shellCmd.Flags().StringVarP(&serviceName, "service", "", "", "Service Name or ID (application, container or job)")
+	shellCmd.Flags().StringVarP(&podName, "pod", "", "", "Instance (pod) to connect to, picked interactively when the service has several")
+	shellCmd.Flags().StringVarP(&podContainerName, "container", "", "", "Container of the pod to connect to, e.g. to reach a sidecar")
+	shellCmd.Flags().StringVarP(&shellRecordPath, "record", "", "", "Record the session to an asciicast file, see 'qovery shell replay'")
 }
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/pkg"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var replaySpeed float64
+var replayMaxIdle time.Duration
+
+var shellReplayCmd = &cobra.Command{
+	Use:   "replay <file>",
+	Short: "Replay a shell session recorded with --record",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		metadata, err := pkg.ReplaySession(args[0], os.Stdout, replaySpeed, replayMaxIdle)
+		if err != nil {
+			return err
+		}
+
+		if metadata != nil {
+			fmt.Println()
+			_ = pterm.DefaultTable.WithData(pterm.TableData{
+				{"User", metadata.User},
+				{"Organization", metadata.OrganizationID},
+				{"Project", metadata.ProjectID},
+				{"Environment", metadata.EnvironmentID},
+				{"Service", metadata.ServiceID},
+				{"Pod", metadata.PodName},
+				{"Command", metadata.Command},
+				{"Started at", metadata.StartTime},
+			}).Render()
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	shellCmd.AddCommand(shellReplayCmd)
+	shellReplayCmd.Flags().Float64VarP(&replaySpeed, "speed", "", 1, "Playback speed factor")
+	shellReplayCmd.Flags().DurationVarP(&replayMaxIdle, "max-idle", "", 2*time.Second, "Shorten the pauses longer than this duration, 0 to keep them")
+}
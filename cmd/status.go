@@ -6,24 +6,21 @@ import (
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-cli/utils"
 	"github.com/spf13/cobra"
-	"os"
 )
 
 var statusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Print the status of your application",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 
 		tokenType, token, err := utils.GetAccessToken()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(0)
+			return err
 		}
 		service, err := utils.CurrentService()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(0)
+			return err
 		}
 
 		client := utils.GetQoveryClient(tokenType, token)
@@ -32,38 +29,36 @@ var statusCmd = &cobra.Command{
 		case utils.ApplicationType:
 			status, res, err := client.ApplicationMainCallsApi.GetApplicationStatus(context.Background(), string(service.ID)).Execute()
 			if err != nil {
-				utils.PrintlnError(err)
-				os.Exit(0)
+				return err
 			}
 			if res.StatusCode >= 400 {
-				utils.PrintlnError(errors.New("Received " + res.Status + " response while listing organizations. "))
+				return errors.New("Received " + res.Status + " response while getting the status. ")
 			}
 
 			err = pterm.DefaultTable.WithData(pterm.TableData{{"Application", "Status"}, {string(service.Name), string(status.State)}}).Render()
 			if err != nil {
-				utils.PrintlnError(err)
-				os.Exit(0)
+				return err
 			}
 		case utils.ContainerType:
 			status, res, err := client.ContainerMainCallsApi.GetContainerStatus(context.Background(), string(service.ID)).Execute()
 			if err != nil {
-				utils.PrintlnError(err)
-				os.Exit(0)
+				return err
 			}
 			if res.StatusCode >= 400 {
-				utils.PrintlnError(errors.New("Received " + res.Status + " response while listing organizations. "))
+				return errors.New("Received " + res.Status + " response while getting the status. ")
 			}
 
 			err = pterm.DefaultTable.WithData(pterm.TableData{{"Container", "Status"}, {string(service.Name), string(status.State)}}).Render()
 			if err != nil {
-				utils.PrintlnError(err)
-				os.Exit(0)
+				return err
 			}
 		}
 
+		return nil
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(statusCmd)
+	statusCmd.Flags().StringVarP(&consoleUrl, "url", "", "", "Qovery console URL of the service, instead of the current context")
 }
@@ -1,92 +1,115 @@
 package cmd
 
 import (
-	"bytes"
-	"encoding/json"
-	"errors"
+	"context"
+	"strings"
+
 	"github.com/qovery/qovery-cli/utils"
+	"github.com/qovery/qovery-client-go"
 	"github.com/spf13/cobra"
-	"io"
-	"net/http"
 )
 
-type TokenCreationResponseDto struct {
-	Token string
-}
+var tokenName string
+var tokenDescription string
 
 var tokenCmd = &cobra.Command{
 	Use:   "token",
-	Short: "Generate an API token",
-	Run: func(cmd *cobra.Command, args []string) {
+	Short: "Generate and manage API tokens",
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
+		return createToken()
+	},
+}
 
+func createToken() error {
+	var tokenInformation *utils.TokenInformation
+	var err error
+
+	if tokenName == "" {
 		utils.PrintlnInfo("Select organization")
-		tokenInformation, err := utils.SelectTokenInformation()
-		if err != nil {
-			utils.PrintlnError(err)
-			return
-		}
-
-		token, err := generateMachineToMachineAPIToken(tokenInformation)
-
-		if err != nil {
-			utils.PrintlnError(err)
-			return
-		}
-
-		utils.PrintlnInfo("---- Never share this authentication token and keep it secure ----")
-		utils.PrintlnInfo(token)
-		utils.PrintlnInfo("---- Never share this authentication token and keep it secure ----")
-	},
+		tokenInformation, err = utils.SelectTokenInformation()
+	} else {
+		tokenInformation, err = getTokenInformationFromFlags()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	token, err := generateMachineToMachineAPIToken(tokenInformation)
+
+	if err != nil {
+		return err
+	}
+
+	utils.PrintlnInfo("---- Never share this authentication token and keep it secure ----")
+	utils.PrintlnInfo(token)
+	utils.PrintlnInfo("---- Never share this authentication token and keep it secure ----")
+	return nil
 }
 
-func generateMachineToMachineAPIToken(tokenInformation *utils.TokenInformation) (string, error) {
+func getTokenInformationFromFlags() (*utils.TokenInformation, error) {
 	tokenType, token, err := utils.GetAccessToken()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	requestBody, err := json.Marshal(map[string]string{
-		"name":        tokenInformation.Name,
-		"description": tokenInformation.Description,
-		"scope":       "ADMIN",
-	})
-
+	client := utils.GetQoveryClient(tokenType, token)
+	organizationId, err := getTokenOrganizationId(client)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	return &utils.TokenInformation{
+		Organization: &utils.Organization{ID: utils.Id(organizationId)},
+		Name:         tokenName,
+		Description:  tokenDescription,
+	}, nil
+}
+
+// getTokenOrganizationId resolves the --organization flag, falling back on the organization of the context
+func getTokenOrganizationId(client *qovery.APIClient) (string, error) {
+	if strings.TrimSpace(organizationName) == "" {
+		id, _, err := utils.CurrentOrganization()
+		return string(id), err
 	}
 
-	// apiToken endpoint is not yet exposed in the OpenAPI spec at the moment. It's planned officially for Q3 2022
-	req, err := http.NewRequest(
-		http.MethodPost,
-		string("https://api.qovery.com/organization/"+tokenInformation.Organization.ID+"/apiToken"),
-		bytes.NewBuffer(requestBody),
-	)
+	organizations, _, err := client.OrganizationMainCallsApi.ListOrganization(context.Background()).Execute()
 	if err != nil {
 		return "", err
 	}
 
-	req.Header.Set("Authorization", utils.GetAuthorizationHeaderValue(tokenType, token))
-	req.Header.Set("Content-Type", "application/json")
+	organization := utils.FindByOrganizationName(organizations.GetResults(), organizationName)
+	if organization == nil {
+		return "", &utils.NotFoundError{Level: "organization", Name: organizationName, Candidates: utils.OrganizationNames(organizations.GetResults())}
+	}
+
+	return organization.Id, nil
+}
 
-	res, err := http.DefaultClient.Do(req)
+// generateMachineToMachineAPIToken creates an ADMIN token: the API neither takes another role nor an expiration date,
+// a token is valid until it is deleted
+func generateMachineToMachineAPIToken(tokenInformation *utils.TokenInformation) (string, error) {
+	tokenType, token, err := utils.GetAccessToken()
 	if err != nil {
 		return "", err
 	}
 
-	if res.StatusCode >= 400 {
-		return "", errors.New("Received " + res.Status + " response while fetching environment. ")
-	}
+	client := utils.GetQoveryClient(tokenType, token)
 
-	jsonResponse, _ := io.ReadAll(res.Body)
-	var tokenCreationResponseDto TokenCreationResponseDto
+	request := qovery.NewOrganizationApiTokenCreateRequest(tokenInformation.Name, qovery.ORGANIZATIONAPITOKENSCOPE_ADMIN)
+	if tokenInformation.Description != "" {
+		request.SetDescription(tokenInformation.Description)
+	}
 
-	err = json.Unmarshal(jsonResponse, &tokenCreationResponseDto)
+	created, _, err := client.OrganizationApiTokenApi.CreateOrganizationApiToken(context.Background(), string(tokenInformation.Organization.ID)).
+		OrganizationApiTokenCreateRequest(*request).
+		Execute()
 	if err != nil {
 		return "", err
 	}
 
-	return tokenCreationResponseDto.Token, nil
+	return created.GetToken(), nil
 }
 
 func init() {
@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var tokenCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an API token, interactively when no name is given",
+	Long: `Create an API token, interactively when no name is given.
+The token has the ADMIN scope on its organization and does not expire: the API does not support other roles nor
+expiration dates yet. Revoke it with 'qovery token delete'.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+		return createToken()
+	},
+}
+
+func init() {
+	tokenCmd.AddCommand(tokenCreateCmd)
+	tokenCreateCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	tokenCreateCmd.Flags().StringVarP(&tokenName, "name", "n", "", "Token Name")
+	tokenCreateCmd.Flags().StringVarP(&tokenDescription, "description", "", "", "Token Description")
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var tokenDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Revoke an API token",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		organizationId, err := getTokenOrganizationId(client)
+		if err != nil {
+			return err
+		}
+
+		tokens, _, err := client.OrganizationApiTokenApi.ListOrganizationApiTokens(context.Background(), organizationId).Execute()
+		if err != nil {
+			return err
+		}
+
+		var tokenIds []string
+		tokenNames := make([]string, 0)
+		for _, t := range tokens.GetResults() {
+			if t.Id == tokenName || t.GetName() == tokenName {
+				tokenIds = append(tokenIds, t.Id)
+			}
+			tokenNames = append(tokenNames, t.GetName())
+		}
+
+		if len(tokenIds) == 0 {
+			return &utils.NotFoundError{Level: "API token", Name: tokenName, Candidates: tokenNames}
+		}
+
+		if len(tokenIds) > 1 {
+			return fmt.Errorf("several API tokens are named %s, use the token ID instead", tokenName)
+		}
+
+		_, err = client.OrganizationApiTokenApi.DeleteOrganizationApiToken(context.Background(), organizationId, tokenIds[0]).Execute()
+		if err != nil {
+			return err
+		}
+
+		utils.Println(fmt.Sprintf("API token %s has been revoked", pterm.FgBlue.Sprintf(tokenName)))
+
+		return nil
+	},
+}
+
+func init() {
+	tokenCmd.AddCommand(tokenDeleteCmd)
+	tokenDeleteCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+	tokenDeleteCmd.Flags().StringVarP(&tokenName, "name", "n", "", "Token Name or ID")
+
+	_ = tokenDeleteCmd.MarkFlagRequired("name")
+}
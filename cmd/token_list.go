@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/qovery/qovery-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var tokenListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List API tokens",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		utils.Capture(cmd)
+
+		tokenType, token, err := utils.GetAccessToken()
+		if err != nil {
+			return err
+		}
+
+		client := utils.GetQoveryClient(tokenType, token)
+		organizationId, err := getTokenOrganizationId(client)
+		if err != nil {
+			return err
+		}
+
+		tokens, _, err := client.OrganizationApiTokenApi.ListOrganizationApiTokens(context.Background(), organizationId).Execute()
+		if err != nil {
+			return err
+		}
+
+		var data [][]string
+		for _, t := range tokens.GetResults() {
+			scope := ""
+			if t.Scope != nil {
+				scope = string(*t.Scope)
+			}
+
+			data = append(data, []string{t.Id, t.GetName(), t.GetDescription(), scope, t.CreatedAt.Format("2006-01-02 15:04")})
+		}
+
+		err = utils.PrintTable([]string{"Id", "Name", "Description", "Scope", "Created at"}, data)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	tokenCmd.AddCommand(tokenListCmd)
+	tokenListCmd.Flags().StringVarP(&organizationName, "organization", "", "", "Organization Name or ID")
+}
@@ -24,7 +24,7 @@ var upgradeCmd = &cobra.Command{
 	Short: "Upgrade Qovery CLI to latest version",
 	Long: `UPGRADE performs an upgrade of the binary. For example:
 	qovery upgrade`,
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		currentBinaryFilename, _ := osext.Executable()
 		filename := "qovery"
 		archivePath := "/tmp/"
@@ -37,7 +37,7 @@ var upgradeCmd = &cobra.Command{
 		available, message, desiredVersion := pkg.CheckAvailableNewVersion()
 		if !available {
 			fmt.Print(message)
-			os.Exit(0)
+			return nil
 		}
 
 		url := fmt.Sprintf("https://github.com/Qovery/qovery-cli/releases/download/v%s/qovery-cli_%s_%s_%s.tar.gz",
@@ -45,29 +45,25 @@ var upgradeCmd = &cobra.Command{
 
 		binaryWriteAccess := unix.Access(currentBinaryFilename, unix.W_OK)
 		if binaryWriteAccess != nil {
-			utils.PrintlnError(fmt.Errorf("Upgrade cancelled: no write permission on the Qovery CLI binary file: %s", currentBinaryFilename))
 			cleanArchives(cleanList)
-			os.Exit(0)
+			return fmt.Errorf("Upgrade cancelled: no write permission on the Qovery CLI binary file: %s", currentBinaryFilename)
 		}
 
 		resp, err := http.Get(url)
 		if err != nil {
-			utils.PrintlnError(fmt.Errorf("Error while downloading the latest version: %s", err))
-			os.Exit(0)
+			return fmt.Errorf("Error while downloading the latest version: %s", err)
 		}
 		defer resp.Body.Close()
 
 		out, err := os.Create(archivePathName)
 		if err != nil {
-			utils.PrintlnError(fmt.Errorf("Error while overriding Qovery CLI binary file: %s", err))
-			os.Exit(0)
+			return fmt.Errorf("Error while overriding Qovery CLI binary file: %s", err)
 		}
 		defer out.Close()
 
 		_, err = iio.Copy(out, resp.Body)
 		if err != nil {
-			utils.PrintlnError(fmt.Errorf("Error while adding content to Qovery CLI binary file: %s", err))
-			os.Exit(0)
+			return fmt.Errorf("Error while adding content to Qovery CLI binary file: %s", err)
 		}
 
 		if _, err := os.Stat(uncompressPath); !os.IsNotExist(err) {
@@ -76,8 +72,7 @@ var upgradeCmd = &cobra.Command{
 
 		err = archiver.Unarchive(archivePathName, uncompressPath)
 		if err != nil {
-			utils.PrintlnError(fmt.Errorf("Error while uncompressing the archive: %s", err))
-			os.Exit(0)
+			return fmt.Errorf("Error while uncompressing the archive: %s", err)
 		}
 
 		// Fork to avoid override issue on a a running program
@@ -86,9 +81,10 @@ var upgradeCmd = &cobra.Command{
 			currentBinaryFilename)
 		err = command.Start()
 		if err != nil {
-			utils.PrintlnError(err)
-			os.Exit(0)
+			return err
 		}
+
+		return nil
 	},
 }
 
@@ -101,7 +97,6 @@ func cleanArchives(listToRemove []string) {
 		err := os.RemoveAll(value)
 		if err != nil {
 			utils.PrintlnError(fmt.Errorf("Error while removing the element: %s", err))
-			os.Exit(0)
 		}
 	}
 }
@@ -10,9 +10,11 @@ import (
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Print installed version of the Qovery CLI",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		utils.Capture(cmd)
 		utils.PrintlnInfo(fmt.Sprintf("%s\n", pkg.GetCurrentVersion()))
+
+		return nil
 	},
 }
 
@@ -0,0 +1,353 @@
+package pkg
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// CopyToContainer streams a local file or directory to remotePath as a tar archive extracted by the remote 'tar'.
+// The SHA-256 of every copied file is checked against the remote one.
+func CopyToContainer(req *ShellRequest, localPath string, remotePath string, progress io.Writer) error {
+	if _, err := os.Stat(localPath); err != nil {
+		return err
+	}
+
+	// copying into an existing directory keeps the local name, like cp
+	isDir := strings.HasSuffix(remotePath, "/")
+	remotePath = path.Clean(remotePath)
+	if !isDir {
+		var err error
+		isDir, err = isRemoteDir(req, remotePath)
+		if err != nil {
+			return err
+		}
+	}
+	if isDir {
+		remotePath = path.Join(remotePath, filepath.Base(filepath.Clean(localPath)))
+	}
+	reader, writer := io.Pipe()
+	counter := newProgressCounter(progress, "Uploaded")
+
+	go func() {
+		err := writeTar(io.MultiWriter(writer, counter), localPath, path.Base(remotePath))
+		_ = writer.CloseWithError(err)
+	}()
+
+	command := *req
+	command.Command = []string{"tar", "xf", "-", "-C", path.Dir(remotePath)}
+
+	var stderr bytes.Buffer
+	exitCode, err := ExecCommandWithStreams(&command, reader, io.Discard, &stderr)
+	counter.Done()
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("remote tar exited with code %d: %s", exitCode, strings.TrimSpace(stderr.String()))
+	}
+
+	return verifyChecksums(req, localPath, remotePath, true)
+}
+
+// CopyFromContainer streams remotePath as a tar archive created by the remote 'tar' and extracts it to localPath.
+// The SHA-256 of every copied file is checked against the remote one.
+func CopyFromContainer(req *ShellRequest, remotePath string, localPath string, progress io.Writer) error {
+	remotePath = path.Clean(remotePath)
+
+	// copying into an existing directory keeps the remote name, like cp
+	if info, err := os.Stat(localPath); err == nil && info.IsDir() {
+		localPath = filepath.Join(localPath, path.Base(remotePath))
+	}
+
+	reader, writer := io.Pipe()
+	counter := newProgressCounter(progress, "Downloaded")
+
+	extracted := make(chan error, 1)
+	go func() {
+		err := extractTar(io.TeeReader(reader, counter), localPath)
+		// drain what is left so the websocket is not blocked
+		_, _ = io.Copy(io.Discard, reader)
+		extracted <- err
+	}()
+
+	command := *req
+	command.Command = []string{"tar", "cf", "-", "-C", path.Dir(remotePath), path.Base(remotePath)}
+
+	var stderr bytes.Buffer
+	exitCode, err := ExecCommandWithStreams(&command, nil, writer, &stderr)
+	_ = writer.CloseWithError(err)
+	extractErr := <-extracted
+	counter.Done()
+
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("remote tar exited with code %d: %s", exitCode, strings.TrimSpace(stderr.String()))
+	}
+	if extractErr != nil {
+		return extractErr
+	}
+
+	return verifyChecksums(req, localPath, remotePath, false)
+}
+
+// isRemoteDir tells if remotePath is an existing directory of the container
+func isRemoteDir(req *ShellRequest, remotePath string) (bool, error) {
+	command := *req
+	command.Command = []string{"test", "-d", remotePath}
+
+	var stderr bytes.Buffer
+	exitCode, err := ExecCommandWithStreams(&command, nil, io.Discard, &stderr)
+	if err != nil {
+		return false, err
+	}
+
+	switch exitCode {
+	case 0:
+		return true, nil
+	case 1:
+		return false, nil
+	default:
+		return false, fmt.Errorf("can't check the remote path %s: %s", remotePath, strings.TrimSpace(stderr.String()))
+	}
+}
+
+// writeTar archives localPath under the name root
+func writeTar(w io.Writer, localPath string, root string) error {
+	tw := tar.NewWriter(w)
+
+	err := filepath.Walk(localPath, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() && !info.IsDir() {
+			// sockets, devices and symlinks are not copied
+			return nil
+		}
+
+		relative, err := filepath.Rel(localPath, file)
+		if err != nil {
+			return err
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = path.Join(root, filepath.ToSlash(relative))
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+// extractTar extracts an archive whose entries are all under one root, renamed to localPath
+func extractTar(r io.Reader, localPath string) error {
+	tr := tar.NewReader(r)
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// drop the remote root name, and refuse entries escaping it
+		name := path.Clean(header.Name)
+		_, relative, _ := strings.Cut(name, "/")
+		if relative == ".." || strings.HasPrefix(relative, "../") || path.IsAbs(relative) {
+			return fmt.Errorf("refusing to extract %s outside of %s", header.Name, localPath)
+		}
+
+		target := filepath.Join(localPath, filepath.FromSlash(relative))
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(header.Mode).Perm())
+			if err != nil {
+				return err
+			}
+
+			_, err = io.Copy(f, tr)
+			_ = f.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// verifyChecksums checks that every file of the source, local or remote, has the same SHA-256 on the destination.
+// The destination may hold more files, e.g. when copying into an existing directory.
+func verifyChecksums(req *ShellRequest, localPath string, remotePath string, fromLocal bool) error {
+	local, err := localChecksums(localPath)
+	if err != nil {
+		return err
+	}
+
+	remote, err := remoteChecksums(req, remotePath)
+	if err != nil {
+		return err
+	}
+
+	source, destination := remote, local
+	if fromLocal {
+		source, destination = local, remote
+	}
+
+	var mismatches []string
+	for file, checksum := range source {
+		if destination[file] != checksum {
+			mismatches = append(mismatches, path.Join(remotePath, file))
+		}
+	}
+
+	if len(mismatches) > 0 {
+		sort.Strings(mismatches)
+		return fmt.Errorf("checksum mismatch, the copy is incomplete: %s", strings.Join(mismatches, ", "))
+	}
+
+	return nil
+}
+
+// localChecksums returns the SHA-256 of the regular files under localPath, by slash separated relative path,
+// the one of a file being keyed by an empty path
+func localChecksums(localPath string) (map[string]string, error) {
+	checksums := make(map[string]string)
+
+	err := filepath.Walk(localPath, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		relative, err := filepath.Rel(localPath, file)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		hash := sha256.New()
+		if _, err := io.Copy(hash, f); err != nil {
+			return err
+		}
+
+		if relative == "." {
+			relative = ""
+		}
+
+		checksums[filepath.ToSlash(relative)] = hex.EncodeToString(hash.Sum(nil))
+		return nil
+	})
+
+	return checksums, err
+}
+
+// remoteChecksums returns the SHA-256 of the regular files under remotePath, keyed like localChecksums
+func remoteChecksums(req *ShellRequest, remotePath string) (map[string]string, error) {
+	command := *req
+	command.Command = []string{"find", remotePath, "-type", "f", "-exec", "sha256sum", "{}", ";"}
+
+	var stdout, stderr bytes.Buffer
+	exitCode, err := ExecCommandWithStreams(&command, nil, &stdout, &stderr)
+	if err != nil {
+		return nil, err
+	}
+	if exitCode != 0 {
+		return nil, fmt.Errorf("can't compute the remote checksums: %s", strings.TrimSpace(stderr.String()))
+	}
+
+	checksums := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(stdout.String()), "\n") {
+		checksum, file, found := strings.Cut(line, "  ")
+		if !found {
+			continue
+		}
+
+		relative := strings.TrimPrefix(strings.TrimPrefix(file, remotePath), "/")
+		checksums[relative] = checksum
+	}
+
+	return checksums, nil
+}
+
+// progressCounter prints the number of bytes transferred, at most every 100ms
+type progressCounter struct {
+	out     io.Writer
+	label   string
+	count   int64
+	printed time.Time
+}
+
+func newProgressCounter(out io.Writer, label string) *progressCounter {
+	return &progressCounter{out: out, label: label}
+}
+
+func (p *progressCounter) Write(b []byte) (int, error) {
+	p.count += int64(len(b))
+	if time.Since(p.printed) > 100*time.Millisecond {
+		p.print()
+	}
+
+	return len(b), nil
+}
+
+func (p *progressCounter) Done() {
+	p.print()
+	_, _ = fmt.Fprintln(p.out)
+}
+
+func (p *progressCounter) print() {
+	p.printed = time.Now()
+	_, _ = fmt.Fprintf(p.out, "\r%s %.1f MB", p.label, float64(p.count)/1024/1024)
+}
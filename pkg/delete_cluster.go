@@ -6,40 +6,60 @@ import (
 	"net/http"
 	"strings"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/qovery/qovery-cli/utils"
 )
 
-func DeleteClusterById(clusterId string, dryRunDisabled bool) {
-	utils.CheckAdminUrl()
+func DeleteClusterById(clusterId string, dryRunDisabled bool) error {
+	if err := utils.CheckAdminUrl(); err != nil {
+		return err
+	}
 
 	utils.DryRunPrint(dryRunDisabled)
-	if utils.Validate("delete") {
-		res := delete(utils.AdminUrl+"/cluster/"+clusterId, http.MethodDelete, dryRunDisabled)
+	validated, err := utils.Validate("delete")
+	if err != nil {
+		return err
+	}
+	if validated {
+		res, err := delete(utils.AdminUrl+"/cluster/"+clusterId, http.MethodDelete, dryRunDisabled)
+		if err != nil {
+			return err
+		}
 
 		if !dryRunDisabled {
 			fmt.Println("Cluster with id " + clusterId + " deletable.")
 		} else if !strings.Contains(res.Status, "200") {
 			result, _ := io.ReadAll(res.Body)
-			log.Errorf("Could not delete cluster with id %s : %s. %s", clusterId, res.Status, string(result))
+			return fmt.Errorf("Could not delete cluster with id %s : %s. %s", clusterId, res.Status, string(result))
 		} else {
 			fmt.Println("Cluster with id " + clusterId + " deleted.")
 		}
 	}
+
+	return nil
 }
-func DeleteClusterUnDeployedInError() {
-	utils.CheckAdminUrl()
+func DeleteClusterUnDeployedInError() error {
+	if err := utils.CheckAdminUrl(); err != nil {
+		return err
+	}
 
-	if utils.Validate("delete") {
-		res := delete(utils.AdminUrl+"/cluster/deleteNotDeployedInErrorClusters", http.MethodPost, true)
+	validated, err := utils.Validate("delete")
+	if err != nil {
+		return err
+	}
+	if validated {
+		res, err := delete(utils.AdminUrl+"/cluster/deleteNotDeployedInErrorClusters", http.MethodPost, true)
+		if err != nil {
+			return err
+		}
 
 		if !strings.Contains(res.Status, "200") {
 			result, _ := io.ReadAll(res.Body)
-			log.Errorf("Could not delete all clusters undeployed and in error : %s. %s", res.Status, string(result))
+			return fmt.Errorf("Could not delete all clusters undeployed and in error : %s. %s", res.Status, string(result))
 		} else {
 			result, _ := io.ReadAll(res.Body)
 			fmt.Println("Clusters deleted: " + string(result))
 		}
 	}
+
+	return nil
 }
@@ -6,25 +6,34 @@ import (
 	"net/http"
 	"strings"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/qovery/qovery-cli/utils"
 )
 
-func DeleteProjectById(projectId string, dryRunDisabled bool) {
-	utils.CheckAdminUrl()
+func DeleteProjectById(projectId string, dryRunDisabled bool) error {
+	if err := utils.CheckAdminUrl(); err != nil {
+		return err
+	}
 
 	utils.DryRunPrint(dryRunDisabled)
-	if utils.Validate("delete") {
-		res := delete(utils.AdminUrl+"/project/"+projectId, http.MethodDelete, dryRunDisabled)
+	validated, err := utils.Validate("delete")
+	if err != nil {
+		return err
+	}
+	if validated {
+		res, err := delete(utils.AdminUrl+"/project/"+projectId, http.MethodDelete, dryRunDisabled)
+		if err != nil {
+			return err
+		}
 
 		if !dryRunDisabled {
 			fmt.Println("Project with id " + projectId + " deletable.")
 		} else if !(strings.Contains(res.Status, "200") || strings.Contains(res.Status, "204")) {
 			result, _ := io.ReadAll(res.Body)
-			log.Errorf("Could not delete project with id %s : %s. %s", projectId, res.Status, string(result))
+			return fmt.Errorf("Could not delete project with id %s : %s. %s", projectId, res.Status, string(result))
 		} else {
 			fmt.Println("Project with id " + projectId + " deleted.")
 		}
 	}
+
+	return nil
 }
@@ -4,69 +4,99 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/qovery/qovery-cli/utils"
-	log "github.com/sirupsen/logrus"
 	"io"
 	"net/http"
-	"os"
 	"strings"
 )
 
-func DeployById(clusterId string, dryRunDisabled bool) {
-	utils.CheckAdminUrl()
+func DeployById(clusterId string, dryRunDisabled bool) error {
+	if err := utils.CheckAdminUrl(); err != nil {
+		return err
+	}
 
 	utils.DryRunPrint(dryRunDisabled)
-	if utils.Validate("deployment") {
-		res := deploy(utils.AdminUrl+"/cluster/deploy/"+clusterId, http.MethodPost, dryRunDisabled)
+	validated, err := utils.Validate("deployment")
+	if err != nil {
+		return err
+	}
+	if validated {
+		res, err := deploy(utils.AdminUrl+"/cluster/deploy/"+clusterId, http.MethodPost, dryRunDisabled)
+		if err != nil {
+			return err
+		}
 
 		if !strings.Contains(res.Status, "200") {
 			result, _ := io.ReadAll(res.Body)
-			log.Errorf("Could not deploy cluster : %s. %s", res.Status, string(result))
+			return fmt.Errorf("Could not deploy cluster : %s. %s", res.Status, string(result))
 		} else if !dryRunDisabled {
 			fmt.Println("Cluster " + clusterId + " deployable.")
 		} else {
 			fmt.Println("Cluster " + clusterId + " deploying.")
 		}
 	}
+
+	return nil
 }
 
-func DeployAll(dryRunDisabled bool) {
-	utils.CheckAdminUrl()
+func DeployAll(dryRunDisabled bool) error {
+	if err := utils.CheckAdminUrl(); err != nil {
+		return err
+	}
 
 	utils.DryRunPrint(dryRunDisabled)
-	if utils.Validate("deployment") {
-		res := deploy(utils.AdminUrl+"/cluster/deploy", http.MethodPost, dryRunDisabled)
+	validated, err := utils.Validate("deployment")
+	if err != nil {
+		return err
+	}
+	if validated {
+		res, err := deploy(utils.AdminUrl+"/cluster/deploy", http.MethodPost, dryRunDisabled)
+		if err != nil {
+			return err
+		}
 
 		if !strings.Contains(res.Status, "200") {
 			result, _ := io.ReadAll(res.Body)
-			log.Errorf("Could not deploy clusters : %s. %s", res.Status, string(result))
+			return fmt.Errorf("Could not deploy clusters : %s. %s", res.Status, string(result))
 		} else if !dryRunDisabled {
 			fmt.Println("Clusters deployable.")
 		} else {
 			fmt.Println("Clusters deploying.")
 		}
 	}
+
+	return nil
 }
 
-func DeployFailedClusters() {
-	utils.CheckAdminUrl()
+func DeployFailedClusters() error {
+	if err := utils.CheckAdminUrl(); err != nil {
+		return err
+	}
 
-	if utils.Validate("deployment") {
-		res := deploy(utils.AdminUrl+"/cluster/deployFailedClusters", http.MethodPost, true)
+	validated, err := utils.Validate("deployment")
+	if err != nil {
+		return err
+	}
+	if validated {
+		res, err := deploy(utils.AdminUrl+"/cluster/deployFailedClusters", http.MethodPost, true)
+		if err != nil {
+			return err
+		}
 
 		if !strings.Contains(res.Status, "200") {
 			result, _ := io.ReadAll(res.Body)
-			log.Errorf("Could not deploy clusters : %s. %s", res.Status, string(result))
+			return fmt.Errorf("Could not deploy clusters : %s. %s", res.Status, string(result))
 		} else {
 			fmt.Println("Clusters deploying.")
 		}
 	}
+
+	return nil
 }
 
-func deploy(url string, method string, dryRunDisabled bool) *http.Response {
+func deploy(url string, method string, dryRunDisabled bool) (*http.Response, error) {
 	tokenType, token, err := utils.GetAccessToken()
 	if err != nil {
-		utils.PrintlnError(err)
-		os.Exit(0)
+		return nil, err
 	}
 
 	body := bytes.NewBuffer([]byte(`{ "metadata": { "dry_run_deploy": true } }`))
@@ -77,16 +107,11 @@ func deploy(url string, method string, dryRunDisabled bool) *http.Response {
 
 	req, err := http.NewRequest(method, url, body)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	req.Header.Set("Authorization", utils.GetAuthorizationHeaderValue(tokenType, token))
 	req.Header.Set("Content-Type", "application/json")
 
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	return res
+	return http.DefaultClient.Do(req)
 }
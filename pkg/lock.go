@@ -3,6 +3,7 @@ package pkg
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,18 +12,21 @@ import (
 	"time"
 
 	"github.com/qovery/qovery-cli/utils"
-	log "github.com/sirupsen/logrus"
 )
 
-func LockedClusters() {
-	utils.CheckAdminUrl()
+func LockedClusters() error {
+	if err := utils.CheckAdminUrl(); err != nil {
+		return err
+	}
 
-	res := listLockedClusters()
+	res, err := listLockedClusters()
+	if err != nil {
+		return err
+	}
 
 	if res.StatusCode != http.StatusOK {
 		result, _ := io.ReadAll(res.Body)
-		log.Errorf("Could not list locked clusters : %s. %s", res.Status, string(result))
-		return
+		return fmt.Errorf("Could not list locked clusters : %s. %s", res.Status, string(result))
 	}
 
 	resp := struct {
@@ -37,10 +41,10 @@ func LockedClusters() {
 
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 	if err := json.Unmarshal(body, &resp); err != nil {
-		log.Fatal(err)
+		return err
 	}
 
 	w := tabwriter.NewWriter(os.Stdout, 1, 1, 1, ' ', 0)
@@ -49,71 +53,86 @@ func LockedClusters() {
 	for idx, lock := range resp.Results {
 		fmt.Fprintf(w, format, fmt.Sprintf("%d", idx+1), lock.ClusterId, lock.LockedAt.Format(time.RFC1123), lock.OwnerName, lock.Reason)
 	}
-	w.Flush()
+	return w.Flush()
 }
 
-func LockById(clusterId string, reason string) {
-	utils.CheckAdminUrl()
+func LockById(clusterId string, reason string) error {
+	if err := utils.CheckAdminUrl(); err != nil {
+		return err
+	}
 
 	if reason == "" {
-		log.Errorf("Lock reason is required")
-		return
+		return &utils.UsageError{Err: errors.New("Lock reason is required")}
 	}
 
-	if utils.Validate("lock") {
-		res := updateLockById(clusterId, reason, http.MethodPost)
+	validated, err := utils.Validate("lock")
+	if err != nil {
+		return err
+	}
+	if validated {
+		res, err := updateLockById(clusterId, reason, http.MethodPost)
+		if err != nil {
+			return err
+		}
 
 		if res.StatusCode != http.StatusOK {
 			result, _ := io.ReadAll(res.Body)
-			log.Errorf("Could not lock cluster : %s. %s", res.Status, string(result))
+			return fmt.Errorf("Could not lock cluster : %s. %s", res.Status, string(result))
 		} else {
 			fmt.Println("Cluster locked.")
 		}
 	}
+
+	return nil
 }
 
-func UnockById(clusterId string) {
-	utils.CheckAdminUrl()
+func UnockById(clusterId string) error {
+	if err := utils.CheckAdminUrl(); err != nil {
+		return err
+	}
 
-	if utils.Validate("unlock") {
-		res := updateLockById(clusterId, "", http.MethodDelete)
+	validated, err := utils.Validate("unlock")
+	if err != nil {
+		return err
+	}
+	if validated {
+		res, err := updateLockById(clusterId, "", http.MethodDelete)
+		if err != nil {
+			return err
+		}
 
 		if res.StatusCode != http.StatusOK {
 			result, _ := io.ReadAll(res.Body)
-			log.Errorf("Could not unlock cluster : %s. %s", res.Status, string(result))
+			return fmt.Errorf("Could not unlock cluster : %s. %s", res.Status, string(result))
 		} else {
 			fmt.Println("Cluster unlocked.")
 		}
 	}
+
+	return nil
 }
 
-func listLockedClusters() *http.Response {
+func listLockedClusters() (*http.Response, error) {
 	tokenType, token, err := utils.GetAccessToken()
 	if err != nil {
-		utils.PrintlnError(err)
-		os.Exit(0)
+		return nil, err
 	}
 
 	url := fmt.Sprintf("%s/cluster/lock", utils.AdminUrl)
 	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 	req.Header.Set("Authorization", utils.GetAuthorizationHeaderValue(tokenType, token))
 	req.Header.Set("Content-Type", "application/json")
 
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		log.Fatal(err)
-	}
-	return res
+	return http.DefaultClient.Do(req)
 }
 
-func updateLockById(clusterId string, reason string, method string) *http.Response {
+func updateLockById(clusterId string, reason string, method string) (*http.Response, error) {
 	tokenType, token, err := utils.GetAccessToken()
 	if err != nil {
-		utils.PrintlnError(err)
-		os.Exit(0)
+		return nil, err
 	}
 
 	payload := map[string]string{}
@@ -122,20 +141,16 @@ func updateLockById(clusterId string, reason string, method string) *http.Respon
 	}
 	body, err := json.Marshal(payload)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	url := fmt.Sprintf("%s/cluster/lock/%s", utils.AdminUrl, clusterId)
 	req, err := http.NewRequest(method, url, bytes.NewBuffer(body))
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 	req.Header.Set("Authorization", utils.GetAuthorizationHeaderValue(tokenType, token))
 	req.Header.Set("Content-Type", "application/json")
 
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		log.Fatal(err)
-	}
-	return res
+	return http.DefaultClient.Do(req)
 }
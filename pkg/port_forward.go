@@ -0,0 +1,119 @@
+package pkg
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+)
+
+type PortForwardRequest struct {
+	ShellRequest
+	LocalPort  int
+	RemotePort int
+}
+
+// ParsePortMapping parses LOCAL:REMOTE, or a single port used on both sides
+func ParsePortMapping(mapping string) (int, int, error) {
+	local, remote, found := strings.Cut(mapping, ":")
+	if !found {
+		remote = local
+	}
+
+	localPort, err := strconv.Atoi(local)
+	if err != nil || localPort < 0 || localPort > 65535 {
+		return 0, 0, fmt.Errorf("invalid local port in %s", mapping)
+	}
+
+	remotePort, err := strconv.Atoi(remote)
+	if err != nil || remotePort <= 0 || remotePort > 65535 {
+		return 0, 0, fmt.Errorf("invalid remote port in %s", mapping)
+	}
+
+	return localPort, remotePort, nil
+}
+
+// ExecPortForward listens on the local port and tunnels each accepted connection over its own websocket,
+// so several clients can be connected at once. ready is called with the bound address once listening.
+func ExecPortForward(req *PortForwardRequest, ready func(addr *net.TCPAddr)) error {
+	listener, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", req.LocalPort))
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+
+	ready(listener.Addr().(*net.TCPAddr))
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		go forwardConnection(req, conn)
+	}
+}
+
+func forwardConnection(req *PortForwardRequest, conn net.Conn) {
+	defer conn.Close()
+
+	query := req.query()
+	query.Set("port", strconv.Itoa(req.RemotePort))
+
+	wsConn, err := dialWebsocket("/shell/portforward", query)
+	if err != nil {
+		log.Error("error while creating websocket connection: ", err)
+		return
+	}
+	defer wsConn.Close()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		defer conn.Close()
+		for {
+			_, msg, err := wsConn.ReadMessage()
+			if err != nil {
+				if !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+					log.Error("error while reading on websocket: ", err)
+				}
+				return
+			}
+
+			if _, err := conn.Write(msg); err != nil {
+				return
+			}
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		buffer := make([]byte, StdinBufferSize)
+		for {
+			count, err := conn.Read(buffer)
+			if count > 0 {
+				if err := wsConn.WriteMessage(websocket.BinaryMessage, buffer[0:count]); err != nil {
+					return
+				}
+			}
+
+			if err != nil {
+				if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+					log.Error("error while reading on local connection: ", err)
+				}
+				_ = wsConn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+				return
+			}
+		}
+	}()
+
+	wg.Wait()
+}
@@ -0,0 +1,185 @@
+package pkg
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/containerd/console"
+)
+
+// RecordingMetadata identifies who opened a recorded shell session and where
+type RecordingMetadata struct {
+	User           string `json:"user"`
+	OrganizationID string `json:"organization_id"`
+	ProjectID      string `json:"project_id"`
+	EnvironmentID  string `json:"environment_id"`
+	ServiceID      string `json:"service_id"`
+	PodName        string `json:"pod_name,omitempty"`
+	// Command is set for the sessions running a command rather than an interactive shell
+	Command   string `json:"command,omitempty"`
+	StartTime string `json:"start_time"`
+}
+
+// asciicastHeader is the first line of an asciicast v2 file, players ignore the 'qovery' field
+type asciicastHeader struct {
+	Version   int                `json:"version"`
+	Width     uint16             `json:"width"`
+	Height    uint16             `json:"height"`
+	Timestamp int64              `json:"timestamp"`
+	Title     string             `json:"title,omitempty"`
+	Env       map[string]string  `json:"env,omitempty"`
+	Qovery    *RecordingMetadata `json:"qovery,omitempty"`
+}
+
+// SessionRecorder writes a shell session as an asciicast v2 file: a JSON header followed by one
+// [elapsed seconds, event type, data] line per event. The end of the session is written as a marker event.
+type SessionRecorder struct {
+	mutex  sync.Mutex
+	file   *os.File
+	writer *bufio.Writer
+	start  time.Time
+}
+
+func NewSessionRecorder(path string, size console.WinSize, metadata RecordingMetadata) (*SessionRecorder, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("can't create session recording: %s", err)
+	}
+
+	start := time.Now()
+	metadata.StartTime = start.UTC().Format(time.RFC3339)
+
+	header, err := json.Marshal(asciicastHeader{
+		Version:   2,
+		Width:     size.Width,
+		Height:    size.Height,
+		Timestamp: start.Unix(),
+		Title:     fmt.Sprintf("qovery shell %s", metadata.ServiceID),
+		Env:       map[string]string{"TERM": os.Getenv("TERM")},
+		Qovery:    &metadata,
+	})
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	recorder := &SessionRecorder{file: file, writer: bufio.NewWriter(file), start: start}
+	_, _ = recorder.writer.Write(append(header, '\n'))
+
+	return recorder, nil
+}
+
+// Output records what the remote shell printed. A nil recorder records nothing.
+func (r *SessionRecorder) Output(data []byte) {
+	r.event("o", string(data))
+}
+
+// Exit records the exit code of a command
+func (r *SessionRecorder) Exit(code int) {
+	r.event("m", fmt.Sprintf("exit %d", code))
+}
+
+// Write records the output of a command, stdout and stderr being merged like on a terminal. Without a remote TTY
+// lines end with '\n' only, a carriage return is added for the players.
+func (r *SessionRecorder) Write(data []byte) (int, error) {
+	r.Output(bytes.ReplaceAll(data, []byte("\n"), []byte("\r\n")))
+	return len(data), nil
+}
+
+func (r *SessionRecorder) Resize(size console.WinSize) {
+	r.event("r", fmt.Sprintf("%dx%d", size.Width, size.Height))
+}
+
+// Close records the end time of the session
+func (r *SessionRecorder) Close() error {
+	if r == nil {
+		return nil
+	}
+
+	r.event("m", "end "+time.Now().UTC().Format(time.RFC3339))
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.writer.Flush(); err != nil {
+		_ = r.file.Close()
+		return err
+	}
+
+	return r.file.Close()
+}
+
+func (r *SessionRecorder) event(eventType string, data string) {
+	if r == nil {
+		return
+	}
+
+	line, err := json.Marshal([]interface{}{time.Since(r.start).Seconds(), eventType, data})
+	if err != nil {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	_, _ = r.writer.Write(append(line, '\n'))
+}
+
+// ReplaySession prints the output of an asciicast v2 recording with its original timing, divided by speed.
+// Pauses are shortened to maxIdle when it is positive.
+func ReplaySession(path string, out io.Writer, speed float64, maxIdle time.Duration) (*RecordingMetadata, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	if !scanner.Scan() {
+		return nil, errors.New("empty recording")
+	}
+
+	var header asciicastHeader
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil || header.Version != 2 {
+		return nil, fmt.Errorf("%s is not an asciicast v2 recording", path)
+	}
+
+	if speed <= 0 {
+		speed = 1
+	}
+
+	previous := 0.0
+	for scanner.Scan() {
+		var event []interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil || len(event) != 3 {
+			return header.Qovery, fmt.Errorf("invalid event in recording: %s", scanner.Text())
+		}
+
+		elapsed, _ := event[0].(float64)
+		eventType, _ := event[1].(string)
+		data, _ := event[2].(string)
+
+		delay := time.Duration((elapsed - previous) / speed * float64(time.Second))
+		if maxIdle > 0 && delay > maxIdle {
+			delay = maxIdle
+		}
+		previous = elapsed
+		time.Sleep(delay)
+
+		if eventType == "o" {
+			if _, err := io.WriteString(out, data); err != nil {
+				return header.Qovery, err
+			}
+		}
+	}
+
+	return header.Qovery, scanner.Err()
+}
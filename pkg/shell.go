@@ -1,9 +1,17 @@
 package pkg
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"syscall"
 
 	"github.com/containerd/console"
 	"github.com/gorilla/websocket"
@@ -13,6 +21,16 @@ import (
 
 const StdinBufferSize = 4096
 
+// Without a TTY the messages are multiplexed like Kubernetes exec streams: the first byte is the channel
+const (
+	stdinChannel  = 0
+	stdoutChannel = 1
+	stderrChannel = 2
+	statusChannel = 3
+	// closeChannel signals the end of a stream, followed by the channel closed
+	closeChannel = 255
+)
+
 type ShellRequest struct {
 	ServiceID      utils.Id
 	ApplicationID  utils.Id
@@ -20,57 +38,286 @@ type ShellRequest struct {
 	ProjectID      utils.Id
 	OrganizationID utils.Id
 	ClusterID      utils.Id
+	ServiceType    utils.ServiceType
+	// PodName and ContainerName target an instance and a container of the service, the server picks them when empty
+	PodName       string
+	ContainerName string
+	// initial size of the remote TTY
+	TtyWidth  uint16
+	TtyHeight uint16
+	// Command is run instead of an interactive shell when set
+	Command []string
+	// RecordPath is the asciicast file the session is recorded to, when set
+	RecordPath        string
+	RecordingMetadata RecordingMetadata
+}
+
+// resizeMessage is sent as a text message when the local terminal is resized, stdin is sent as binary messages
+type resizeMessage struct {
+	Type   string `json:"type"`
+	Width  uint16 `json:"width"`
+	Height uint16 `json:"height"`
 }
 
-func ExecShell(req *ShellRequest) {
+func ExecShell(req *ShellRequest) error {
+	currentConsole := console.Current()
+	size, err := currentConsole.Size()
+	if err == nil {
+		req.TtyWidth, req.TtyHeight = size.Width, size.Height
+	}
+
 	wsConn, err := createWebsocketConn(req)
 	if err != nil {
-		log.Fatal("error while creating websocket connection", err)
+		return fmt.Errorf("error while creating websocket connection: %s", err)
 	}
 	defer func() {
-		if err := wsConn.Close(); err != nil {
-			log.Fatal("error while closing websocket connection", err)
-		}
+		_ = wsConn.Close()
 	}()
 
-	currentConsole := console.Current()
+	var recorder *SessionRecorder
+	if req.RecordPath != "" {
+		recorder, err = NewSessionRecorder(req.RecordPath, console.WinSize{Width: req.TtyWidth, Height: req.TtyHeight}, req.RecordingMetadata)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := recorder.Close(); err != nil {
+				log.Error("error while writing session recording: ", err)
+			}
+		}()
+	}
+
 	if err := currentConsole.SetRaw(); err != nil {
-		log.Fatal("error while setting up console", err)
+		return fmt.Errorf("error while setting up console: %s", err)
 	}
+	// the terminal is restored on every exit path, os.Exit must not be used below this point
+	defer func() {
+		_ = currentConsole.Reset()
+	}()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	defer signal.Stop(signals)
 
+	var closeOnce sync.Once
 	done := make(chan struct{})
+	closeDone := func() {
+		closeOnce.Do(func() { close(done) })
+	}
+	// stops the goroutines below whichever way the session ends
+	defer closeDone()
+
 	stdIn := make(chan []byte)
+	resize := make(chan console.WinSize)
+	detach := make(chan struct{})
 
-	go readWebsocketConnection(wsConn, currentConsole, done)
-	go readUserConsole(currentConsole, stdIn, done)
+	go readWebsocketConnection(wsConn, currentConsole, recorder, closeDone)
+	go readUserConsole(currentConsole, stdIn, detach, done, closeDone)
+	go watchConsoleSize(currentConsole, resize, done)
 
 	for {
 		select {
 		case <-done:
-			return
+			return nil
+		case <-detach:
+			_ = currentConsole.Reset()
+			fmt.Println("\nDetached from shell")
+			return nil
+		case sig := <-signals:
+			return fmt.Errorf("shell session interrupted by %s", sig)
+		case size := <-resize:
+			recorder.Resize(size)
+			msg, _ := json.Marshal(resizeMessage{Type: "resize", Width: size.Width, Height: size.Height})
+			if err := wsConn.WriteMessage(websocket.TextMessage, msg); err != nil {
+				return fmt.Errorf("error while writing on websocket: %s", err)
+			}
 		case msg := <-stdIn:
 			if err := wsConn.WriteMessage(websocket.BinaryMessage, msg); err != nil {
-				log.Error("error while writing on websocket:", err)
+				return fmt.Errorf("error while writing on websocket: %s", err)
+			}
+		}
+	}
+}
+
+// ExecCommand runs req.Command without a TTY. The command stdout and stderr are written to the local ones,
+// stdin is forwarded when it is not a terminal, and the exit code of the command is returned.
+func ExecCommand(req *ShellRequest) (int, error) {
+	var stdin io.Reader
+	if _, err := console.ConsoleFromFile(os.Stdin); err != nil {
+		stdin = os.Stdin
+	}
+
+	if req.RecordPath == "" {
+		return ExecCommandWithStreams(req, stdin, os.Stdout, os.Stderr)
+	}
+
+	// there is no remote TTY, the recording uses the size of the local terminal like a replay would
+	size := console.WinSize{Width: 80, Height: 24}
+	if current, err := console.ConsoleFromFile(os.Stdout); err == nil {
+		if currentSize, err := current.Size(); err == nil {
+			size = currentSize
+		}
+	}
+
+	recorder, err := NewSessionRecorder(req.RecordPath, size, req.RecordingMetadata)
+	if err != nil {
+		return 1, err
+	}
+	defer func() {
+		if err := recorder.Close(); err != nil {
+			log.Error("error while writing session recording: ", err)
+		}
+	}()
+
+	exitCode, err := ExecCommandWithStreams(req, stdin, io.MultiWriter(os.Stdout, recorder), io.MultiWriter(os.Stderr, recorder))
+	if err == nil {
+		recorder.Exit(exitCode)
+	}
+
+	return exitCode, err
+}
+
+// ExecCommandWithStreams runs req.Command without a TTY, stdin is not forwarded when nil
+func ExecCommandWithStreams(req *ShellRequest, stdin io.Reader, stdout io.Writer, stderr io.Writer) (int, error) {
+	wsConn, err := createWebsocketConn(req)
+	if err != nil {
+		return 1, fmt.Errorf("error while creating websocket connection: %s", err)
+	}
+	defer func() {
+		_ = wsConn.Close()
+	}()
+
+	if stdin != nil {
+		go forwardStdin(wsConn, stdin)
+	}
+
+	for {
+		_, msg, err := wsConn.ReadMessage()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				// the exit status is always sent before a normal close, the output may be truncated
+				return 1, errors.New("connection closed before the command exit status was received")
+			}
+			return 1, fmt.Errorf("error while reading on websocket: %s", err)
+		}
+
+		if len(msg) == 0 {
+			continue
+		}
+
+		switch msg[0] {
+		case stdoutChannel:
+			if _, err := stdout.Write(msg[1:]); err != nil {
+				return 1, err
+			}
+		case stderrChannel:
+			if _, err := stderr.Write(msg[1:]); err != nil {
+				return 1, err
+			}
+		case statusChannel:
+			return parseExitCode(msg[1:])
+		}
+	}
+}
+
+func forwardStdin(wsConn *websocket.Conn, stdin io.Reader) {
+	buffer := make([]byte, StdinBufferSize)
+	for {
+		count, err := stdin.Read(buffer)
+		if count > 0 {
+			if err := wsConn.WriteMessage(websocket.BinaryMessage, append([]byte{stdinChannel}, buffer[0:count]...)); err != nil {
 				return
 			}
 		}
+
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				log.Error("error while reading stdin: ", err)
+			}
+			_ = wsConn.WriteMessage(websocket.BinaryMessage, []byte{closeChannel, stdinChannel})
+			return
+		}
+	}
+}
+
+// execStatus is the Kubernetes status sent once the command has exited
+type execStatus struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Details struct {
+		Causes []struct {
+			Reason  string `json:"reason"`
+			Message string `json:"message"`
+		} `json:"causes"`
+	} `json:"details"`
+}
+
+func parseExitCode(msg []byte) (int, error) {
+	var status execStatus
+	if err := json.Unmarshal(msg, &status); err != nil {
+		return 1, fmt.Errorf("can't read command exit status: %s", err)
+	}
+
+	if status.Status == "Success" {
+		return 0, nil
+	}
+
+	for _, cause := range status.Details.Causes {
+		if cause.Reason == "ExitCode" {
+			if code, err := strconv.Atoi(cause.Message); err == nil {
+				return code, nil
+			}
+		}
 	}
+
+	// the command could not be started at all
+	return 1, errors.New(status.Message)
 }
 
 func createWebsocketConn(req *ShellRequest) (*websocket.Conn, error) {
-	wsURL, err := url.Parse(fmt.Sprintf(
-		"wss://ws.qovery.com/shell/exec?service=%s&application=%s&cluster=%s&environment=%s&organization=%s&project=%s",
-		req.ServiceID,
-		req.ApplicationID,
-		req.ClusterID,
-		req.EnvironmentID,
-		req.OrganizationID,
-		req.ProjectID,
-	))
+	query := req.query()
+
+	if req.ContainerName != "" {
+		query.Set("container_name", req.ContainerName)
+	}
+
+	if len(req.Command) > 0 {
+		query["command"] = req.Command
+		query.Set("tty", "false")
+	} else if req.TtyWidth > 0 && req.TtyHeight > 0 {
+		query.Set("tty_width", strconv.Itoa(int(req.TtyWidth)))
+		query.Set("tty_height", strconv.Itoa(int(req.TtyHeight)))
+	}
+
+	return dialWebsocket("/shell/exec", query)
+}
+
+// query identifies the service (and its instance) targeted by the request
+func (req *ShellRequest) query() url.Values {
+	query := url.Values{
+		"service":      {string(req.ServiceID)},
+		"application":  {string(req.ApplicationID)},
+		"cluster":      {string(req.ClusterID)},
+		"environment":  {string(req.EnvironmentID)},
+		"organization": {string(req.OrganizationID)},
+		"project":      {string(req.ProjectID)},
+	}
+
+	if req.PodName != "" {
+		query.Set("pod_name", req.PodName)
+	}
+
+	return query
+}
+
+func dialWebsocket(path string, query url.Values) (*websocket.Conn, error) {
+	wsURL, err := url.Parse("wss://ws.qovery.com" + path)
 	if err != nil {
 		return nil, err
 	}
 
+	wsURL.RawQuery = query.Encode()
+
 	tokenType, token, err := utils.GetAccessToken()
 	if err != nil {
 		return nil, err
@@ -84,8 +331,8 @@ func createWebsocketConn(req *ShellRequest) (*websocket.Conn, error) {
 	return wsConn, nil
 }
 
-func readWebsocketConnection(wsConn *websocket.Conn, currentConsole console.Console, done chan struct{}) {
-	defer close(done)
+func readWebsocketConnection(wsConn *websocket.Conn, currentConsole console.Console, recorder *SessionRecorder, closeDone func()) {
+	defer closeDone()
 	for {
 		_, msg, err := wsConn.ReadMessage()
 		if err != nil {
@@ -96,6 +343,7 @@ func readWebsocketConnection(wsConn *websocket.Conn, currentConsole console.Cons
 			log.Error("error while reading on websocket:", err)
 			return
 		}
+		recorder.Output(msg)
 		if _, err = currentConsole.Write(msg); err != nil {
 			log.Error("error while writing in console:", err)
 			return
@@ -103,15 +351,63 @@ func readWebsocketConnection(wsConn *websocket.Conn, currentConsole console.Cons
 	}
 }
 
-func readUserConsole(currentConsole console.Console, stdIn chan []byte, done chan struct{}) {
-	defer close(done)
+// readUserConsole stops sending once done is closed, the shell not reading stdIn anymore
+func readUserConsole(currentConsole console.Console, stdIn chan []byte, detach chan struct{}, done chan struct{}, closeDone func()) {
+	defer closeDone()
 	buffer := make([]byte, StdinBufferSize)
+	escape := escapeSequenceFilter{atLineStart: true}
 	for {
 		count, err := currentConsole.Read(buffer)
 		if err != nil {
 			log.Error("error while reading on console:", err)
 			return
 		}
-		stdIn <- buffer[0:count]
+
+		input, detached := escape.Filter(buffer[0:count])
+		if len(input) > 0 {
+			select {
+			case stdIn <- input:
+			case <-done:
+				return
+			}
+		}
+		if detached {
+			close(detach)
+			return
+		}
+	}
+}
+
+// escapeSequenceFilter detects '~.' typed at the beginning of a line to detach from the shell, like ssh.
+// '~~' sends a single '~'.
+type escapeSequenceFilter struct {
+	atLineStart  bool
+	pendingTilde bool
+}
+
+func (f *escapeSequenceFilter) Filter(input []byte) ([]byte, bool) {
+	output := make([]byte, 0, len(input))
+	for _, b := range input {
+		if f.pendingTilde {
+			f.pendingTilde = false
+			switch b {
+			case '.':
+				return output, true
+			case '~':
+				output = append(output, '~')
+				f.atLineStart = false
+				continue
+			default:
+				output = append(output, '~')
+			}
+		} else if f.atLineStart && b == '~' {
+			f.pendingTilde = true
+			continue
+		}
+
+		output = append(output, b)
+		f.atLineStart = b == '\r' || b == '\n'
 	}
+
+	return output, false
 }
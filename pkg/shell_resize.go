@@ -0,0 +1,37 @@
+//go:build !windows
+// +build !windows
+
+package pkg
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/containerd/console"
+)
+
+// watchConsoleSize sends the console size each time the terminal is resized (SIGWINCH)
+func watchConsoleSize(currentConsole console.Console, resize chan console.WinSize, done chan struct{}) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGWINCH)
+	defer signal.Stop(signals)
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-signals:
+			size, err := currentConsole.Size()
+			if err != nil {
+				continue
+			}
+
+			select {
+			case resize <- size:
+			case <-done:
+				return
+			}
+		}
+	}
+}
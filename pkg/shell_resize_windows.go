@@ -0,0 +1,33 @@
+package pkg
+
+import (
+	"time"
+
+	"github.com/containerd/console"
+)
+
+// watchConsoleSize polls the console size, Windows has no resize signal
+func watchConsoleSize(currentConsole console.Console, resize chan console.WinSize, done chan struct{}) {
+	ticker := time.NewTicker(250 * time.Millisecond)
+	defer ticker.Stop()
+
+	last, _ := currentConsole.Size()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			size, err := currentConsole.Size()
+			if err != nil || size == last {
+				continue
+			}
+			last = size
+
+			select {
+			case resize <- size:
+			case <-done:
+				return
+			}
+		}
+	}
+}
@@ -7,37 +7,57 @@ import (
 	log "github.com/sirupsen/logrus"
 	"io"
 	"net/http"
-	"os"
 	"strings"
 )
 
-func UpdateById(clusterId string, dryRunDisabled bool, version string) {
-	utils.CheckAdminUrl()
+func UpdateById(clusterId string, dryRunDisabled bool, version string) error {
+	if err := utils.CheckAdminUrl(); err != nil {
+		return err
+	}
 
 	utils.DryRunPrint(dryRunDisabled)
-	if utils.Validate("update") {
-		res := update(utils.AdminUrl+"/cluster/update/"+clusterId, http.MethodPost, dryRunDisabled, version, "", 0)
+	validated, err := utils.Validate("update")
+	if err != nil {
+		return err
+	}
+	if validated {
+		res, err := update(utils.AdminUrl+"/cluster/update/"+clusterId, http.MethodPost, dryRunDisabled, version, "", 0)
+		if err != nil {
+			return err
+		}
 
 		if !strings.Contains(res.Status, "200") {
 			result, _ := io.ReadAll(res.Body)
-			log.Errorf("Could not update cluster : %s. %s", res.Status, string(result))
+			return fmt.Errorf("Could not update cluster : %s. %s", res.Status, string(result))
 		} else if !dryRunDisabled {
 			fmt.Println("Cluster " + clusterId + " updatable.")
 		} else {
 			fmt.Println("Cluster " + clusterId + " updating.")
 		}
 	}
+
+	return nil
 }
 
-func UpdateAll(dryRunDisabled bool, version string, providerKind string, parallelRun int) {
-	utils.CheckAdminUrl()
+func UpdateAll(dryRunDisabled bool, version string, providerKind string, parallelRun int) error {
+	if err := utils.CheckAdminUrl(); err != nil {
+		return err
+	}
 
 	utils.DryRunPrint(dryRunDisabled)
-	if utils.Validate("update") {
-		res := update(utils.AdminUrl+"/cluster/update", http.MethodPost, dryRunDisabled, version, providerKind, parallelRun)
+	validated, err := utils.Validate("update")
+	if err != nil {
+		return err
+	}
+	if validated {
+		res, err := update(utils.AdminUrl+"/cluster/update", http.MethodPost, dryRunDisabled, version, providerKind, parallelRun)
+		if err != nil {
+			return err
+		}
+
 		result, _ := io.ReadAll(res.Body)
 		if strings.Contains(res.Status, "40") || strings.Contains(res.Status, "50") {
-			log.Errorf("Could not update clusters : %s. %s", res.Status, string(result))
+			return fmt.Errorf("Could not update clusters : %s. %s", res.Status, string(result))
 		} else {
 			depl := "Deployable"
 			if dryRunDisabled {
@@ -46,13 +66,14 @@ func UpdateAll(dryRunDisabled bool, version string, providerKind string, paralle
 			log.Infof("%s clusters: %s", depl, result)
 		}
 	}
+
+	return nil
 }
 
-func update(url string, method string, dryRunDisabled bool, version string, providerKind string, parallelRun int) *http.Response {
+func update(url string, method string, dryRunDisabled bool, version string, providerKind string, parallelRun int) (*http.Response, error) {
 	tokenType, token, err := utils.GetAccessToken()
 	if err != nil {
-		utils.PrintlnError(err)
-		os.Exit(0)
+		return nil, err
 	}
 
 	content := fmt.Sprintf(`{ "metadata": { "dry_run_deploy": %t, "target_version": "%s", "provider_kind": "%s", "parallel_run": %d } }`, !dryRunDisabled, version, providerKind, parallelRun)
@@ -60,16 +81,11 @@ func update(url string, method string, dryRunDisabled bool, version string, prov
 
 	req, err := http.NewRequest(method, url, body)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	req.Header.Set("Authorization", utils.GetAuthorizationHeaderValue(tokenType, token))
 	req.Header.Set("Content-Type", "application/json")
 
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	return res
+	return http.DefaultClient.Do(req)
 }
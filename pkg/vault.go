@@ -2,14 +2,15 @@ package pkg
 
 import (
 	b64 "encoding/base64"
+	"errors"
+	"fmt"
 	"os"
 
 	"github.com/hashicorp/vault/api"
 	"github.com/qovery/qovery-cli/utils"
-	log "github.com/sirupsen/logrus"
 )
 
-func connectToVault() *api.Client {
+func connectToVault() (*api.Client, error) {
 	var token = os.Getenv("VAULT_TOKEN")
 	var vaultAddr = os.Getenv("VAULT_ADDR")
 
@@ -19,28 +20,26 @@ func connectToVault() *api.Client {
 	client, err := api.NewClient(config)
 
 	if err != nil {
-		log.Error("Can't create Vault client : " + err.Error())
-		return nil
+		return nil, fmt.Errorf("Can't create Vault client : %s", err)
 	}
 
 	client.SetToken(token)
 
-	return client
+	return client, nil
 }
 
-func GetVarsByClusterId(clusterID string) []utils.Var {
-	client := connectToVault()
+func GetVarsByClusterId(clusterID string) ([]utils.Var, error) {
+	client, err := connectToVault()
+	if err != nil {
+		return nil, err
+	}
 
 	result, err := client.Logical().Read("/official-clusters-access/data/" + clusterID)
 	if err != nil {
-		log.Error(err)
-		os.Exit(1)
-		panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+		return nil, err
 	}
 	if result == nil {
-		log.Error("Cluster information are not found")
-		os.Exit(1)
-		panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+		return nil, &utils.NotFoundError{Level: "cluster", Name: clusterID, Parent: "Vault"}
 	}
 
 	var vaultVars []utils.Var
@@ -55,13 +54,15 @@ func GetVarsByClusterId(clusterID string) []utils.Var {
 		case "kubeconfig_b64", "KUBECONFIG_b64":
 			decodedValue, encErr := b64.StdEncoding.DecodeString(value.(string))
 			if encErr != nil {
-				log.Error("Can't decode KUBECONFIG")
-				return []utils.Var{}
+				return nil, errors.New("Can't decode KUBECONFIG")
+			}
+			filePath, err := utils.WriteInFile(clusterID, "kubeconfig", decodedValue)
+			if err != nil {
+				return nil, err
 			}
-			filePath := utils.WriteInFile(clusterID, "kubeconfig", decodedValue)
 			vaultVars = append(vaultVars, utils.Var{Key: "KUBECONFIG", Value: filePath})
 		}
 	}
 
-	return vaultVars
+	return vaultVars, nil
 }
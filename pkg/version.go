@@ -4,10 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"os"
 	"strings"
-
-	"github.com/qovery/qovery-cli/utils"
 )
 
 func GetCurrentVersion() string {
@@ -26,8 +23,7 @@ func GetLatestOnlineVersionUrl() (string, error) {
 func GetLatestOnlineVersionNumber() (string, error) {
 	urlPath, err := GetLatestOnlineVersionUrl()
 	if err != nil {
-		utils.PrintlnError(err)
-		os.Exit(0)
+		return "", err
 	}
 	splitUrl := strings.Split(urlPath, "/v")
 	return splitUrl[len(splitUrl)-1], nil
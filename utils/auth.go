@@ -3,60 +3,241 @@ package utils
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
+	"os"
 	"strings"
 	"time"
-	"bytes"
+
+	"github.com/golang-jwt/jwt"
 )
 
 type TokensResponse struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
 }
 
-var (
-	oAuthUrlParamValueClient = "MJ2SJpu12PxIzgmc5z5Y7N8m5MnaF7Y0"
-	oAuthTokenEndpoint       = "https://auth.qovery.com/oauth/token"
+// OAuth clients of the browser and device (headless) flows
+const (
+	OAuthClientId         = "MJ2SJpu12PxIzgmc5z5Y7N8m5MnaF7Y0"
+	OAuthHeadlessClientId = "f9drkTNpxsEw2VU2PVDrxhyT3vVuFT0Y"
+	OAuthTokenEndpoint    = "https://auth.qovery.com/oauth/token"
+	oAuthRevokeEndpoint   = "https://auth.qovery.com/oauth/revoke"
 )
 
+// AuthStatus describes the credentials used by the CLI
+type AuthStatus struct {
+	// Source is the environment variable or the profile the token comes from
+	Source          string
+	TokenType       AccessTokenType
+	User            string
+	Email           string
+	Expiration      time.Time
+	HasRefreshToken bool
+}
+
+// the access token is refreshed this long before it expires, so it does not expire during a command
+const tokenRefreshMargin = time.Minute
+
+const refreshLockFileName = "refresh.lock"
+const refreshLockTimeout = 30 * time.Second
+
+// TokenExpiration reads the expiry of an access token from its JWT 'exp' claim, falling back on the 'expires_in'
+// of the token response. A token whose lifetime is unknown is considered as expired so it is refreshed on next use.
+func TokenExpiration(accessToken string, expiresIn int64) time.Time {
+	claims := jwt.MapClaims{}
+	_, _, err := new(jwt.Parser).ParseUnverified(accessToken, claims)
+	if err == nil {
+		if exp, ok := claims["exp"].(float64); ok {
+			return time.Unix(int64(exp), 0)
+		}
+	}
+
+	if expiresIn > 0 {
+		return time.Now().Add(time.Duration(expiresIn) * time.Second)
+	}
+
+	return time.Now()
+}
+
+// IsAccessTokenExpiring tells if the access token must be refreshed before being used
+func IsAccessTokenExpiring(expiration time.Time) bool {
+	return time.Now().Add(tokenRefreshMargin).After(expiration)
+}
+
+// RefreshAccessToken exchanges the refresh token for a new access token. Concurrent CLI invocations are serialized
+// with a lock file: the ones waiting for the lock reuse the token refreshed by the first one.
 func RefreshAccessToken() error {
-	token, _ := GetRefreshToken()
-	refreshToken := strings.TrimSpace(string(token))
+	unlock, err := lockTokenRefresh()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	context, err := CurrentContext()
+	if err != nil {
+		return err
+	}
+
+	if context.AccessToken != "" && !IsAccessTokenExpiring(context.AccessTokenExpiration) {
+		// refreshed by another invocation while waiting for the lock
+		return nil
+	}
+
+	refreshToken := strings.TrimSpace(string(context.RefreshToken))
 	if refreshToken == "" {
 		return errors.New("Could not reauthenticate automatically. Please, run 'qovery auth' to authenticate. ")
 	}
-	res, err := http.PostForm(oAuthTokenEndpoint, url.Values{
+
+	res, err := http.PostForm(OAuthTokenEndpoint, url.Values{
 		"grant_type":    {"refresh_token"},
-		"client_id":     {oAuthUrlParamValueClient},
+		"client_id":     {OAuthClientId},
 		"refresh_token": {refreshToken},
 	})
 	if err != nil {
 		return errors.New("Error authenticating in Qovery. Please, contact the #support on 'https://discord.qovery.com'. ")
-	} else {
-		defer res.Body.Close()
-		tokens := TokensResponse{}
-		err := json.NewDecoder(res.Body).Decode(&tokens)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return errors.New("Could not reauthenticate automatically. Please, run 'qovery auth' to authenticate. ")
+	}
+
+	tokens := TokensResponse{}
+	err = json.NewDecoder(res.Body).Decode(&tokens)
+	if err != nil || tokens.AccessToken == "" {
+		return errors.New("Error authenticating in Qovery. Please, contact the #support on 'https://discord.qovery.com'. ")
+	}
+
+	if tokens.RefreshToken == "" {
+		// the refresh token is only sent back when it is rotated
+		tokens.RefreshToken = refreshToken
+	}
+
+	return SetTokens(AccessToken(tokens.AccessToken), TokenExpiration(tokens.AccessToken, tokens.ExpiresIn), RefreshToken(tokens.RefreshToken))
+}
+
+// lockTokenRefresh creates ~/.qovery/refresh.lock exclusively, waiting for another invocation to release it.
+// A lock older than the timeout is left by a killed invocation and is taken over.
+func lockTokenRefresh() (func(), error) {
+	path, err := qoveryFilePath(refreshLockFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(refreshLockTimeout)
+	for {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, privateFileMode)
+		if err == nil {
+			_ = file.Close()
+			return func() { _ = os.Remove(path) }, nil
+		}
+
+		if !os.IsExist(err) {
+			return nil, err
+		}
+
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > refreshLockTimeout {
+			_ = os.Remove(path)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timeout waiting for another qovery command to refresh the access token, remove %s if no other command is running", path)
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// CurrentAuthStatus reads the credentials without refreshing them. Claims are only available for the tokens
+// obtained with 'qovery auth', tokens from QOVERY_CLI_ACCESS_TOKEN are opaque.
+func CurrentAuthStatus() (AuthStatus, error) {
+	if tokenType, token := accessTokenFromEnv(); token != "" {
+		source := "QOVERY_CLI_ACCESS_TOKEN"
+		if os.Getenv(source) == "" {
+			source = "Q_CLI_ACCESS_TOKEN"
+		}
+
+		return AuthStatus{Source: source, TokenType: tokenType}, nil
+	}
+
+	profiles, err := CurrentProfiles()
+	if err != nil {
+		return AuthStatus{}, err
+	}
+
+	context, err := CurrentContext()
+	if err != nil {
+		return AuthStatus{}, err
+	}
+
+	if context.AccessToken == "" {
+		return AuthStatus{}, &AuthError{Err: errors.New("You are not signed in. Please, sign in using 'qovery auth' command. ")}
+	}
+
+	claims := jwt.MapClaims{}
+	_, _, _ = new(jwt.Parser).ParseUnverified(string(context.AccessToken), claims)
+	email, _ := claims["email"].(string)
+	if email == "" {
+		// Auth0 namespaces custom claims
+		for key, value := range claims {
+			if strings.HasSuffix(key, "/email") {
+				email, _ = value.(string)
+			}
+		}
+	}
+
+	return AuthStatus{
+		Source:          "profile " + ActiveProfileName(profiles),
+		TokenType:       profileTokenType(),
+		User:            string(context.User),
+		Email:           email,
+		Expiration:      context.AccessTokenExpiration,
+		HasRefreshToken: context.RefreshToken != "",
+	}, nil
+}
+
+// RevokeRefreshToken invalidates the refresh token of the current profile at the auth server.
+// The client the token was issued to is unknown, both the browser and the headless clients are tried.
+func RevokeRefreshToken() error {
+	token, err := GetRefreshToken()
+	if err != nil {
+		// nothing to revoke
+		return nil
+	}
+
+	for _, client := range []string{OAuthClientId, OAuthHeadlessClientId} {
+		res, err := http.PostForm(oAuthRevokeEndpoint, url.Values{
+			"client_id": {client},
+			"token":     {strings.TrimSpace(string(token))},
+		})
 		if err != nil {
-			return errors.New("Error authenticating in Qovery. Please, contact the #support on 'https://discord.qovery.com'. ")
+			return fmt.Errorf("can't revoke the refresh token: %s", err)
+		}
+		_ = res.Body.Close()
+
+		if res.StatusCode == http.StatusOK {
+			return nil
 		}
-		expiredAt := time.Now().Local().Add(time.Second * time.Duration(30000))
-		PrintlnInfo("new access token")
-		PrintlnInfo(tokens.AccessToken)
-		buf := new(bytes.Buffer)
-    	_, _ = buf.ReadFrom(res.Body)
-    	newStr := buf.String()
-		PrintlnInfo(newStr)
-		_ = SetAccessToken(AccessToken(tokens.AccessToken), expiredAt)
 	}
-	return nil
+
+	return errors.New("the refresh token has been rejected by the auth server, it may already be revoked")
 }
 
-func RefreshExpiredTokenSilently() {
-	token, _ := GetRefreshToken()
-	refreshToken := strings.TrimSpace(string(token))
-	expiration, err := GetAccessTokenExpiration()
-	if err == nil && expiration.Before(time.Now()) && refreshToken != "" {
-		_ = RefreshAccessToken()
+// ClearTokens wipes the tokens of the current profile, its selected organization, project... are kept
+func ClearTokens() error {
+	context, err := CurrentContext()
+	if err != nil {
+		return err
 	}
+
+	context.AccessToken = ""
+	context.AccessTokenExpiration = time.Time{}
+	context.RefreshToken = ""
+	context.User = ""
+
+	return StoreContext(context)
 }
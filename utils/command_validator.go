@@ -3,17 +3,21 @@ package utils
 import (
 	"errors"
 	"fmt"
-	"os"
 
 	"github.com/manifoldco/promptui"
-	log "github.com/sirupsen/logrus"
 )
 
-func Validate(actionType string) bool {
-	return getInput(actionType) == "yes"
+// Validate asks the user to type "yes" before running the action, an interrupted prompt is returned as an error
+func Validate(actionType string) (bool, error) {
+	input, err := getInput(actionType)
+	if err != nil {
+		return false, err
+	}
+
+	return input == "yes", nil
 }
 
-func getInput(actionType string) string {
+func getInput(actionType string) (string, error) {
 	validate := func(input string) error {
 		if len(input) <= 0 {
 			return errors.New("no input")
@@ -36,10 +40,8 @@ func getInput(actionType string) string {
 
 	result, err := prompt.Run()
 	if err != nil {
-		log.Errorf("Prompt failed %v", err)
-		os.Exit(1)
-		panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+		return "", fmt.Errorf("prompt failed: %s", err)
 	}
 
-	return result
+	return result, nil
 }
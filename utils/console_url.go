@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// IsId tells if a value given to a name flag is the ID of the resource rather than its name
+func IsId(value string) bool {
+	return uuidRegexp.MatchString(strings.TrimSpace(value))
+}
+
+// consoleUrlServiceTypes maps the path segments of the console to the service types
+var consoleUrlServiceTypes = map[string]ServiceType{
+	"application":  ApplicationType,
+	"applications": ApplicationType,
+	"container":    ContainerType,
+	"containers":   ContainerType,
+	"database":     DatabaseType,
+	"databases":    DatabaseType,
+	"job":          JobType,
+	"jobs":         JobType,
+}
+
+// ParseConsoleUrl extracts the organization, project, environment and service IDs of a Qovery console URL.
+// Both the current shape, e.g.
+// https://console.qovery.com/organization/<id>/project/<id>/environment/<id>/application/<id>/general
+// and the legacy one, e.g.
+// https://console.qovery.com/platform/organization/<id>/projects/<id>/environments/<id>/applications/<id>/summary
+// are supported. The URL can stop at any level.
+func ParseConsoleUrl(consoleUrl string) (*ContextSelection, error) {
+	u, err := url.Parse(strings.TrimSpace(consoleUrl))
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("%s is not a URL, expected a Qovery console URL like https://console.qovery.com/organization/<id>/project/<id>", consoleUrl)
+	}
+
+	if u.Hostname() != "qovery.com" && !strings.HasSuffix(u.Hostname(), ".qovery.com") {
+		return nil, fmt.Errorf("%s is not a Qovery console URL", consoleUrl)
+	}
+
+	selection := ContextSelection{}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+
+	for i := 0; i+1 < len(segments); i++ {
+		segment, id := segments[i], segments[i+1]
+
+		var level string
+		switch segment {
+		case "organization", "organizations":
+			level = "organization"
+			selection.Organization = id
+		case "project", "projects":
+			level = "project"
+			selection.Project = id
+		case "environment", "environments":
+			level = "environment"
+			selection.Environment = id
+		default:
+			serviceType, ok := consoleUrlServiceTypes[segment]
+			if !ok {
+				continue
+			}
+			level = strings.TrimSuffix(segment, "s")
+			selection.Service = id
+			selection.ServiceType = serviceType
+		}
+
+		if !uuidRegexp.MatchString(id) {
+			return nil, fmt.Errorf("invalid %s ID '%s' in console URL %s", level, id, consoleUrl)
+		}
+
+		i++
+	}
+
+	if selection.Organization == "" {
+		return nil, fmt.Errorf("no organization found in console URL %s", consoleUrl)
+	}
+
+	return &selection, nil
+}
+
+// ResolveConsoleUrl looks up the resources of a console URL, the levels the URL stops before are left empty
+func ResolveConsoleUrl(consoleUrl string) (QoveryContext, error) {
+	selection, err := ParseConsoleUrl(consoleUrl)
+	if err != nil {
+		return QoveryContext{}, err
+	}
+
+	ctx, err := ResolveContextSelection(QoveryContext{}, *selection)
+	if err != nil {
+		return ctx, fmt.Errorf("%s: %s", consoleUrl, err)
+	}
+
+	if selection.ServiceType != "" && ctx.ServiceType != selection.ServiceType {
+		return ctx, fmt.Errorf("%s: %s is a %s, not a %s", consoleUrl, ctx.ServiceName, ctx.ServiceType, selection.ServiceType)
+	}
+
+	return ctx, nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -11,11 +12,23 @@ import (
 )
 
 const ContextFileName = "context"
+const DefaultProfileName = "default"
 
+// ProfileName is the profile selected with the --profile flag, it takes precedence over QOVERY_PROFILE
+var ProfileName string
+
+// QoveryProfiles is the content of the context file: one QoveryContext (tokens and selection) per named profile
+type QoveryProfiles struct {
+	CurrentProfile string                   `json:"current_profile"`
+	Profiles       map[string]QoveryContext `json:"profiles"`
+}
+
+// QoveryContext is the selection and the tokens of a profile. Tokens are never written to the context file,
+// they are kept in the TokenStore.
 type QoveryContext struct {
-	AccessToken           AccessToken  `json:"access_token"`
-	AccessTokenExpiration time.Time    `json:"access_token_expiration"`
-	RefreshToken          RefreshToken `json:"refresh_token"`
+	AccessToken           AccessToken  `json:"-"`
+	AccessTokenExpiration time.Time    `json:"-"`
+	RefreshToken          RefreshToken `json:"-"`
 	OrganizationId        Id           `json:"organization_id"`
 	OrganizationName      Name         `json:"organization_name"`
 	ProjectId             Id           `json:"project_id"`
@@ -27,31 +40,185 @@ type QoveryContext struct {
 	ServiceType           ServiceType  `json:"service_type"`
 	User                  Name         `json:"user"`
 }
+
+// Tokens are the credentials of a profile
+type Tokens struct {
+	AccessToken           AccessToken  `json:"access_token"`
+	AccessTokenExpiration time.Time    `json:"access_token_expiration"`
+	RefreshToken          RefreshToken `json:"refresh_token"`
+}
+
+func (t Tokens) IsEmpty() bool {
+	return t.AccessToken == "" && t.RefreshToken == ""
+}
+
+// TokenStore keeps the tokens of each profile out of the plaintext context file
+type TokenStore interface {
+	// Get returns empty tokens when none are stored for the profile
+	Get(profile string) (Tokens, error)
+	Set(profile string, tokens Tokens) error
+	Delete(profile string) error
+}
+
 type Name string
 type AccessTokenType string
 type AccessToken string
 type RefreshToken string
 type Id string
 
-func CurrentContext() (QoveryContext, error) {
-	context := QoveryContext{}
+func CurrentProfiles() (QoveryProfiles, error) {
+	profiles := QoveryProfiles{}
 
 	path, err := QoveryContextPath()
 	if err != nil {
-		return context, err
+		return profiles, err
 	}
 
 	bytes, err := os.ReadFile(path)
 	if err != nil {
-		return context, err
+		return profiles, err
+	}
+
+	err = restrictPermissions(path)
+	if err != nil {
+		return profiles, err
+	}
+
+	var raw map[string]json.RawMessage
+	err = json.Unmarshal(bytes, &raw)
+	if err != nil {
+		return profiles, err
+	}
+
+	if _, ok := raw["profiles"]; !ok {
+		// context file written before profiles were introduced, it becomes the default profile
+		legacy := QoveryContext{}
+		err = json.Unmarshal(bytes, &legacy)
+		if err != nil {
+			return profiles, err
+		}
+
+		legacyTokens := Tokens{}
+		err = json.Unmarshal(bytes, &legacyTokens)
+		if err != nil {
+			return profiles, err
+		}
+
+		profiles.CurrentProfile = DefaultProfileName
+		profiles.Profiles = map[string]QoveryContext{DefaultProfileName: legacy}
+
+		return profiles, migrateTokens(profiles, map[string]Tokens{DefaultProfileName: legacyTokens})
+	}
+
+	err = json.Unmarshal(bytes, &profiles)
+	if err != nil {
+		return profiles, err
+	}
+
+	if profiles.Profiles == nil {
+		profiles.Profiles = make(map[string]QoveryContext)
+	}
+
+	// context files written before the token store still hold plaintext tokens
+	var plaintext struct {
+		Profiles map[string]Tokens `json:"profiles"`
+	}
+	err = json.Unmarshal(bytes, &plaintext)
+	if err != nil {
+		return profiles, err
+	}
+
+	for _, tokens := range plaintext.Profiles {
+		if !tokens.IsEmpty() {
+			return profiles, migrateTokens(profiles, plaintext.Profiles)
+		}
+	}
+
+	return profiles, nil
+}
+
+// migrateTokens moves plaintext tokens to the TokenStore, then rewrites the context file without them
+func migrateTokens(profiles QoveryProfiles, tokens map[string]Tokens) error {
+	for name, profileTokens := range tokens {
+		if profileTokens.IsEmpty() {
+			continue
+		}
+
+		err := GetTokenStore().Set(name, profileTokens)
+		if err != nil {
+			return err
+		}
+	}
+
+	return StoreProfiles(profiles)
+}
+
+func StoreProfiles(profiles QoveryProfiles) error {
+	bytes, err := json.Marshal(profiles)
+	if err != nil {
+		return err
+	}
+
+	path, err := QoveryContextPath()
+	if err != nil {
+		return err
+	}
+
+	return writePrivateFile(path, bytes)
+}
+
+// ActiveProfileName resolves the profile in use: --profile flag, then QOVERY_PROFILE, then 'qovery context use'
+func ActiveProfileName(profiles QoveryProfiles) string {
+	if ProfileName != "" {
+		return ProfileName
+	}
+
+	if name := os.Getenv("QOVERY_PROFILE"); name != "" {
+		return name
+	}
+
+	if profiles.CurrentProfile != "" {
+		return profiles.CurrentProfile
+	}
+
+	return DefaultProfileName
+}
+
+func UseProfile(name string) error {
+	profiles, err := CurrentProfiles()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := profiles.Profiles[name]; !ok {
+		profiles.Profiles[name] = QoveryContext{}
 	}
 
-	err = json.Unmarshal(bytes, &context)
+	profiles.CurrentProfile = name
+
+	return StoreProfiles(profiles)
+}
+
+func CurrentContext() (QoveryContext, error) {
+	profiles, err := CurrentProfiles()
+	if err != nil {
+		return QoveryContext{}, err
+	}
+
+	// an unknown profile behaves like a new one, it is created on the first write
+	name := ActiveProfileName(profiles)
+	context := profiles.Profiles[name]
+
+	tokens, err := GetTokenStore().Get(name)
 	if err != nil {
 		return context, err
 	}
 
-	return context, err
+	context.AccessToken = tokens.AccessToken
+	context.AccessTokenExpiration = tokens.AccessTokenExpiration
+	context.RefreshToken = tokens.RefreshToken
+
+	return context, nil
 }
 
 func (c QoveryContext) ToPosthogProperties() map[string]interface{} {
@@ -65,21 +232,34 @@ func (c QoveryContext) ToPosthogProperties() map[string]interface{} {
 }
 
 func StoreContext(context QoveryContext) error {
-	bytes, err := json.Marshal(context)
+	profiles, err := CurrentProfiles()
 	if err != nil {
 		return err
 	}
 
-	path, err := QoveryContextPath()
+	name := ActiveProfileName(profiles)
+	profiles.Profiles[name] = context
+
+	tokens := Tokens{
+		AccessToken:           context.AccessToken,
+		AccessTokenExpiration: context.AccessTokenExpiration,
+		RefreshToken:          context.RefreshToken,
+	}
+
+	if tokens.IsEmpty() {
+		err = GetTokenStore().Delete(name)
+	} else {
+		err = GetTokenStore().Set(name, tokens)
+	}
 	if err != nil {
 		return err
 	}
 
-	return os.WriteFile(path, bytes, os.ModePerm)
+	return StoreProfiles(profiles)
 }
 
 func CurrentOrganization() (Id, Name, error) {
-	context, err := CurrentContext()
+	context, err := EffectiveContext()
 	if err != nil {
 		return "", "", err
 	}
@@ -105,11 +285,12 @@ func SetOrganization(orga *Organization) error {
 	context.OrganizationName = orga.Name
 	context.OrganizationId = orga.ID
 
+	resetEffectiveContext()
 	return StoreContext(context)
 }
 
 func CurrentProject() (Id, Name, error) {
-	context, err := CurrentContext()
+	context, err := EffectiveContext()
 	if err != nil {
 		return "", "", err
 	}
@@ -135,11 +316,12 @@ func SetProject(project *Project) error {
 	context.ProjectName = project.Name
 	context.ProjectId = project.ID
 
+	resetEffectiveContext()
 	return StoreContext(context)
 }
 
 func CurrentEnvironment() (Id, Name, error) {
-	context, err := CurrentContext()
+	context, err := EffectiveContext()
 	if err != nil {
 		return "", "", err
 	}
@@ -165,11 +347,12 @@ func SetEnvironment(env *Environment) error {
 	context.EnvironmentName = env.Name
 	context.EnvironmentId = env.ID
 
+	resetEffectiveContext()
 	return StoreContext(context)
 }
 
 func CurrentService() (*Service, error) {
-	context, err := CurrentContext()
+	context, err := EffectiveContext()
 	if err != nil {
 		return nil, err
 	}
@@ -196,6 +379,7 @@ func SetService(service *Service) error {
 	context.ServiceId = service.ID
 	context.ServiceType = service.Type
 
+	resetEffectiveContext()
 	return StoreContext(context)
 }
 
@@ -203,24 +387,35 @@ func GetAuthorizationHeaderValue(tokenType AccessTokenType, token AccessToken) s
 	return string(tokenType) + " " + strings.TrimSpace(string(token))
 }
 
-func GetAccessToken() (AccessTokenType, AccessToken, error) {
-	tokenType := os.Getenv("QOVERY_CLI_ACCESS_TOKEN_TYPE")
+// accessTokenFromEnv returns the API token set in QOVERY_CLI_ACCESS_TOKEN (or Q_CLI_ACCESS_TOKEN), if any
+func accessTokenFromEnv() (AccessTokenType, AccessToken) {
 	token := os.Getenv("QOVERY_CLI_ACCESS_TOKEN")
 
-	if tokenType == "" {
-		tokenType = os.Getenv("Q_CLI_ACCESS_TOKEN_TYPE")
-	}
-
 	if token == "" {
 		token = os.Getenv("Q_CLI_ACCESS_TOKEN")
 	}
 
+	return AccessTokenType("Token"), AccessToken(token)
+}
+
+// profileTokenType is the type of the tokens obtained with 'qovery auth', Bearer unless overridden
+func profileTokenType() AccessTokenType {
+	tokenType := os.Getenv("QOVERY_CLI_ACCESS_TOKEN_TYPE")
+
+	if tokenType == "" {
+		tokenType = os.Getenv("Q_CLI_ACCESS_TOKEN_TYPE")
+	}
+
 	if tokenType == "" {
 		tokenType = "Bearer"
 	}
 
-	if token != "" {
-		return AccessTokenType("Token"), AccessToken(token), nil
+	return AccessTokenType(tokenType)
+}
+
+func GetAccessToken() (AccessTokenType, AccessToken, error) {
+	if envTokenType, token := accessTokenFromEnv(); token != "" {
+		return envTokenType, token, nil
 	}
 
 	context, err := CurrentContext()
@@ -228,64 +423,81 @@ func GetAccessToken() (AccessTokenType, AccessToken, error) {
 		return "", "", err
 	}
 
-	token = string(context.AccessToken)
-	if token == "" {
-		return "", "", errors.New("Access token has not been found. Please, sign in using 'qovery auth' commandee. ")
+	if context.AccessToken == "" {
+		return "", "", &AuthError{Err: errors.New("Access token has not been found. Please, sign in using 'qovery auth' command. ")}
 	}
 
-	expired := context.AccessTokenExpiration.Before(time.Now())
-	if expired {
-		RefreshExpiredTokenSilently()
-		_, refreshed, err := GetAccessToken()
+	if IsAccessTokenExpiring(context.AccessTokenExpiration) {
+		err = RefreshAccessToken()
 		if err != nil {
-			return "", "", err
+			if context.AccessTokenExpiration.Before(time.Now()) {
+				return "", "", &AuthError{Err: err}
+			}
+			// still valid for a few seconds, the refresh is retried on next use
+		} else {
+			context, err = CurrentContext()
+			if err != nil {
+				return "", "", err
+			}
 		}
-		token = string(refreshed)
 	}
 
-	return AccessTokenType(tokenType), AccessToken(token), nil
+	return profileTokenType(), context.AccessToken, nil
 }
 
 func GetAccessTokenExpiration() (time.Time, error) {
 	context, err := CurrentContext()
-	t := time.Time{}
 	if err != nil {
-		return t, err
+		return time.Time{}, err
 	}
 
-	expiration := context.AccessTokenExpiration
-	PrintlnInfo("GetAccessTokenExpiration: ")
-	if expiration == t {
-		PrintlnInfo("GetAccessTokenExpiration: error")
-		return t, errors.New("Access token has not been found. Please, sign in using 'qovery auth' commandeeeee. ")
+	if context.AccessTokenExpiration.IsZero() {
+		return time.Time{}, errors.New("Access token has not been found. Please, sign in using 'qovery auth' command. ")
 	}
 
-	return expiration, nil
+	return context.AccessTokenExpiration, nil
 }
 
-func SetAccessToken(token AccessToken, expiration time.Time) error {
+// SetTokens stores the tokens obtained from a sign in or a refresh at once
+func SetTokens(accessToken AccessToken, expiration time.Time, refreshToken RefreshToken) error {
 	context, err := CurrentContext()
 	if err != nil {
 		return err
 	}
 
-	context.AccessToken = token
+	context.AccessToken = accessToken
 	context.AccessTokenExpiration = expiration
+	context.RefreshToken = refreshToken
+	if user := tokenSubject(accessToken); user != "" {
+		context.User = user
+	}
 
-	claims := jwt.MapClaims{}
-	_, _ = jwt.ParseWithClaims(string(token), claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(""), nil
-	})
+	return StoreContext(context)
+}
 
-	sub, ok := claims["sub"]
-	if ok {
-		subStr := sub.(string)
-		context.User = Name(subStr)
+func SetAccessToken(token AccessToken, expiration time.Time) error {
+	context, err := CurrentContext()
+	if err != nil {
+		return err
+	}
+
+	context.AccessToken = token
+	context.AccessTokenExpiration = expiration
+	if user := tokenSubject(token); user != "" {
+		context.User = user
 	}
 
 	return StoreContext(context)
 }
 
+func tokenSubject(token AccessToken) Name {
+	claims := jwt.MapClaims{}
+	_, _, _ = new(jwt.Parser).ParseUnverified(string(token), claims)
+
+	sub, _ := claims["sub"].(string)
+	return Name(sub)
+}
+
 func GetRefreshToken() (RefreshToken, error) {
 	context, err := CurrentContext()
 	if err != nil {
@@ -293,10 +505,7 @@ func GetRefreshToken() (RefreshToken, error) {
 	}
 
 	token := context.RefreshToken
-	PrintlnInfo("getRefreshToken: ")
-	PrintlnInfo(strings.TrimSpace(string(token)))
 	if token == "" {
-		PrintlnInfo("getRefreshToken: Error")
 		return "", errors.New("Refresh token has not been found. Please, sign in using 'qovery auth' command. ")
 	}
 
@@ -315,13 +524,18 @@ func SetRefreshToken(token RefreshToken) error {
 }
 
 func InitializeQoveryContext() error {
-	if !QoveryDirExists() {
+	exists, err := QoveryDirExists()
+	if err != nil {
+		return err
+	}
+
+	if !exists {
 		path, err := QoveryDirPath()
 		if err != nil {
 			return err
 		}
 
-		err = os.Mkdir(path, os.ModePerm)
+		err = os.Mkdir(path, privateDirMode)
 		if err != nil {
 			return err
 		}
@@ -332,14 +546,23 @@ func InitializeQoveryContext() error {
 		return err
 	}
 
-	_, err = os.Create(path)
-	if err != nil {
-		return err
-	}
+	return writePrivateFile(path, []byte("{}"))
+}
 
-	err = os.WriteFile(path, []byte("{}"), os.ModePerm)
-	if err != nil {
-		return err
+// restrictPermissions tightens the context file and its directory, created world readable by former versions
+func restrictPermissions(contextPath string) error {
+	for path, mode := range map[string]os.FileMode{contextPath: privateFileMode, filepath.Dir(contextPath): privateDirMode} {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+
+		if info.Mode().Perm() != mode {
+			err = os.Chmod(path, mode)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -361,22 +584,20 @@ func QoveryDirPath() (string, error) {
 	return dir + string(os.PathSeparator) + ".qovery", nil
 }
 
-func QoveryDirExists() bool {
+func QoveryDirExists() (bool, error) {
 	path, err := QoveryDirPath()
 	if err != nil {
-		PrintlnError(err)
-		os.Exit(0)
+		return false, err
 	}
-	return pathExists(path)
+	return pathExists(path), nil
 }
 
-func QoveryContextExists() bool {
+func QoveryContextExists() (bool, error) {
 	path, err := QoveryContextPath()
 	if err != nil {
-		PrintlnError(err)
-		os.Exit(0)
+		return false, err
 	}
-	return pathExists(path)
+	return pathExists(path), nil
 }
 
 func pathExists(path string) bool {
@@ -0,0 +1,352 @@
+package utils
+
+import (
+	"bytes"
+	b64 "encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/hashicorp/hcl"
+	"github.com/joho/godotenv"
+	"golang.org/x/term"
+	"gopkg.in/yaml.v3"
+)
+
+// ParseJsonEnvs parses a flat JSON object of variables, as exported by
+// 'heroku config --json' or 'railway variables --json'
+func ParseJsonEnvs(content []byte) (map[string]string, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(content, &raw); err != nil {
+		return nil, err
+	}
+
+	envs := make(map[string]string)
+	for key, value := range raw {
+		envs[key] = scalarToString(value)
+	}
+
+	return envs, nil
+}
+
+// ParseDotEnv parses a dot env file. Variables injected by Vercel in 'vercel env pull' files are dropped
+// when skipVercelSystemEnvs is set, since they are specific to the Vercel platform.
+func ParseDotEnv(content []byte, skipVercelSystemEnvs bool) (map[string]string, error) {
+	envs, err := godotenv.UnmarshalBytes(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if skipVercelSystemEnvs {
+		for key := range envs {
+			if key == "VERCEL" || strings.HasPrefix(key, "VERCEL_") || strings.HasPrefix(key, "TURBO_") || key == "NX_DAEMON" {
+				delete(envs, key)
+			}
+		}
+	}
+
+	return envs, nil
+}
+
+type dockerComposeFile struct {
+	Services map[string]struct {
+		Environment yaml.Node `yaml:"environment"`
+		EnvFile     yaml.Node `yaml:"env_file"`
+	} `yaml:"services"`
+}
+
+// ParseDockerCompose extracts the 'environment' and 'env_file' variables of a docker-compose file.
+// All services are merged unless service is set. env_file paths are relative to the compose file directory.
+func ParseDockerCompose(content []byte, composeDir string, service string) (map[string]string, error) {
+	var compose dockerComposeFile
+	if err := yaml.Unmarshal(content, &compose); err != nil {
+		return nil, err
+	}
+
+	if service != "" {
+		if _, ok := compose.Services[service]; !ok {
+			return nil, fmt.Errorf("service %s not found in docker-compose file", service)
+		}
+	}
+
+	var names []string
+	for name := range compose.Services {
+		if service == "" || name == service {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	envs := make(map[string]string)
+	for _, name := range names {
+		s := compose.Services[name]
+
+		// env_file is loaded first, 'environment' takes precedence like in docker-compose
+		var envFiles []string
+		switch s.EnvFile.Kind {
+		case yaml.ScalarNode:
+			envFiles = append(envFiles, s.EnvFile.Value)
+		case yaml.SequenceNode:
+			for _, node := range s.EnvFile.Content {
+				if node.Kind == yaml.MappingNode {
+					// long syntax: - path: ./file.env
+					var entry struct {
+						Path string `yaml:"path"`
+					}
+					if err := node.Decode(&entry); err != nil {
+						return nil, err
+					}
+					envFiles = append(envFiles, entry.Path)
+				} else {
+					envFiles = append(envFiles, node.Value)
+				}
+			}
+		}
+
+		for _, envFile := range envFiles {
+			if !filepath.IsAbs(envFile) {
+				envFile = filepath.Join(composeDir, envFile)
+			}
+
+			fileEnvs, err := godotenv.Read(envFile)
+			if err != nil {
+				return nil, err
+			}
+
+			for key, value := range fileEnvs {
+				envs[key] = value
+			}
+		}
+
+		switch s.Environment.Kind {
+		case yaml.MappingNode:
+			var environment map[string]interface{}
+			if err := s.Environment.Decode(&environment); err != nil {
+				return nil, err
+			}
+
+			for key, value := range environment {
+				if value == nil {
+					setFromHost(envs, key)
+					continue
+				}
+
+				envs[key] = scalarToString(value)
+			}
+		case yaml.SequenceNode:
+			for _, node := range s.Environment.Content {
+				key, value, found := strings.Cut(node.Value, "=")
+				if !found {
+					setFromHost(envs, key)
+					continue
+				}
+
+				envs[key] = value
+			}
+		}
+	}
+
+	return envs, nil
+}
+
+// setFromHost sets a variable declared without a value to the one of the host, like docker-compose does
+func setFromHost(envs map[string]string, key string) {
+	if value, ok := os.LookupEnv(key); ok {
+		envs[key] = value
+	}
+}
+
+type kubernetesManifest struct {
+	Kind       string            `yaml:"kind"`
+	Data       map[string]string `yaml:"data"`
+	StringData map[string]string `yaml:"stringData"`
+}
+
+// ParseKubernetesManifests extracts the data of the ConfigMap and Secret documents of a (multi documents) YAML manifest
+func ParseKubernetesManifests(content []byte) (map[string]string, error) {
+	envs := make(map[string]string)
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+
+	for {
+		var manifest kubernetesManifest
+		err := decoder.Decode(&manifest)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch manifest.Kind {
+		case "ConfigMap":
+			for key, value := range manifest.Data {
+				envs[key] = value
+			}
+		case "Secret":
+			for key, value := range manifest.Data {
+				decoded, err := b64.StdEncoding.DecodeString(value)
+				if err != nil {
+					return nil, fmt.Errorf("can't decode secret key %s: %s", key, err)
+				}
+				envs[key] = string(decoded)
+			}
+			for key, value := range manifest.StringData {
+				envs[key] = value
+			}
+		}
+	}
+
+	return envs, nil
+}
+
+type renderBlueprint struct {
+	Services []struct {
+		EnvVars []renderEnvVar `yaml:"envVars"`
+	} `yaml:"services"`
+	EnvVarGroups []struct {
+		EnvVars []renderEnvVar `yaml:"envVars"`
+	} `yaml:"envVarGroups"`
+}
+
+type renderEnvVar struct {
+	Key   string  `yaml:"key"`
+	Value *string `yaml:"value"`
+}
+
+// ParseRenderBlueprint extracts the variables with a literal value of a render.yaml blueprint,
+// variables generated or linked by Render (generateValue, fromDatabase, sync: false...) can't be exported
+func ParseRenderBlueprint(content []byte) (map[string]string, error) {
+	var blueprint renderBlueprint
+	if err := yaml.Unmarshal(content, &blueprint); err != nil {
+		return nil, err
+	}
+
+	envs := make(map[string]string)
+	add := func(envVars []renderEnvVar) {
+		for _, envVar := range envVars {
+			if envVar.Key != "" && envVar.Value != nil {
+				envs[envVar.Key] = *envVar.Value
+			}
+		}
+	}
+
+	for _, group := range blueprint.EnvVarGroups {
+		add(group.EnvVars)
+	}
+
+	for _, service := range blueprint.Services {
+		add(service.EnvVars)
+	}
+
+	return envs, nil
+}
+
+// ParseFlyToml extracts the [env] section of a fly.toml file. Fly secrets can't be read back and must be imported separately.
+func ParseFlyToml(content []byte) (map[string]string, error) {
+	var flyConfig struct {
+		Env map[string]interface{} `toml:"env"`
+	}
+	if err := toml.Unmarshal(content, &flyConfig); err != nil {
+		return nil, err
+	}
+
+	envs := make(map[string]string)
+	for key, value := range flyConfig.Env {
+		envs[key] = scalarToString(value)
+	}
+
+	return envs, nil
+}
+
+// ParseTfvars parses Terraform variable definitions (.tfvars), complex values are JSON encoded
+func ParseTfvars(content []byte) (map[string]string, error) {
+	var raw map[string]interface{}
+	if err := hcl.Unmarshal(content, &raw); err != nil {
+		return nil, err
+	}
+
+	envs := make(map[string]string)
+	for key, value := range raw {
+		// HCL v1 decodes objects as a list of maps
+		if objects, ok := value.([]map[string]interface{}); ok && len(objects) == 1 {
+			value = objects[0]
+		}
+
+		envs[key] = scalarToString(value)
+	}
+
+	return envs, nil
+}
+
+// FormatDotEnv renders variables as a sorted KEY=VALUE stream, quoting values which would not survive a dot env parser
+func FormatDotEnv(envs map[string]string) string {
+	var keys []string
+	for key := range envs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, key := range keys {
+		value := envs[key]
+		if strings.ContainsAny(value, "\n\r\"'#$ \t\\") {
+			value = quoteDotEnvValue(value)
+		}
+
+		sb.WriteString(fmt.Sprintf("%s=%s\n", key, value))
+	}
+
+	return sb.String()
+}
+
+// quoteDotEnvValue quotes a value so that a dot env parser reads it back unchanged: single quotes keep it literal,
+// double quotes are only used when it contains a single quote, with '$' escaped so it is not expanded
+func quoteDotEnvValue(value string) string {
+	if !strings.Contains(value, "'") {
+		return "'" + value + "'"
+	}
+
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`, "\n", `\n`, "\r", `\r`)
+	return `"` + replacer.Replace(value) + `"`
+}
+
+// ReadFileOrStdin reads the file at path, or stdin when path is empty or "-"
+// StdinIsTerminal tells if stdin is typed by the user rather than piped or redirected
+func StdinIsTerminal() bool {
+	return term.IsTerminal(int(os.Stdin.Fd()))
+}
+
+func ReadFileOrStdin(path string) ([]byte, error) {
+	if path == "" || path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+
+	return os.ReadFile(path)
+}
+
+func scalarToString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool, int, int64:
+		return fmt.Sprint(v)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+
+		return string(encoded)
+	}
+}
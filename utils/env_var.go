@@ -6,12 +6,16 @@ import (
 	"fmt"
 	"github.com/pterm/pterm"
 	"github.com/qovery/qovery-client-go"
+	"io"
+	"os"
+	"sort"
 	"strings"
 	"time"
 )
 
 var ShowValues bool
 var PrettyPrint bool
+var ShowTree bool
 var IsSecret bool
 var ApplicationScope string
 var JobScope string
@@ -19,6 +23,8 @@ var ContainerScope string
 var Alias string
 var Key string
 var Value string
+var ValueFromFile string
+var ValueFromStdin bool
 
 type EnvVarLines struct {
 	lines map[string][]EnvVarLineOutput
@@ -43,7 +49,8 @@ func (e EnvVarLines) Add(env EnvVarLineOutput) {
 		return
 	}
 
-	e.lines[env.Key] = []EnvVarLineOutput{env}
+	// keep the aliases and overrides already added before their parent
+	e.lines[env.Key] = append([]EnvVarLineOutput{env}, e.lines[env.Key]...)
 }
 
 func (e EnvVarLines) Header(prettyPrint bool) []string {
@@ -57,7 +64,20 @@ func (e EnvVarLines) Header(prettyPrint bool) []string {
 func (e EnvVarLines) Lines(showValues bool, prettyPrint bool) [][]string {
 	var lines [][]string
 
-	for _, envVars := range e.lines {
+	keys := make([]string, 0, len(e.lines))
+	for key := range e.lines {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		envVars := e.lines[key]
+		// the parent stays on top, its aliases and overrides are sorted by key
+		children := envVars[1:]
+		sort.SliceStable(children, func(i, j int) bool {
+			return children[i].Key < children[j].Key
+		})
+
 		for idx, envVar := range envVars {
 			x := envVar.Data(showValues)
 			if idx == 0 || !prettyPrint {
@@ -296,6 +316,45 @@ func FindSecretByKey(key string, secrets []qovery.Secret) *qovery.Secret {
 	return nil
 }
 
+// findMostSpecificEnvironmentVariableByKey returns the variable named key at the most specific scope, so that an
+// update reaches the override of a service rather than the project or environment variable it overrides
+func findMostSpecificEnvironmentVariableByKey(key string, envVars []qovery.EnvironmentVariable) *qovery.EnvironmentVariable {
+	var found *qovery.EnvironmentVariable
+	for i := range envVars {
+		if envVars[i].Key == key && (found == nil || scopeRank(envVars[i].Scope) > scopeRank(found.Scope)) {
+			found = &envVars[i]
+		}
+	}
+
+	return found
+}
+
+// findMostSpecificSecretByKey is findMostSpecificEnvironmentVariableByKey for secrets
+func findMostSpecificSecretByKey(key string, secrets []qovery.Secret) *qovery.Secret {
+	var found *qovery.Secret
+	for i := range secrets {
+		if secrets[i].Key == key && (found == nil || scopeRank(secrets[i].Scope) > scopeRank(found.Scope)) {
+			found = &secrets[i]
+		}
+	}
+
+	return found
+}
+
+// scopeRank orders the scopes from the least specific to the most specific one
+func scopeRank(scope qovery.APIVariableScopeEnum) int {
+	switch scope {
+	case qovery.APIVARIABLESCOPEENUM_BUILT_IN:
+		return 0
+	case qovery.APIVARIABLESCOPEENUM_PROJECT:
+		return 1
+	case qovery.APIVARIABLESCOPEENUM_ENVIRONMENT:
+		return 2
+	default:
+		return 3
+	}
+}
+
 func ListEnvironmentVariables(
 	client *qovery.APIClient,
 	serviceId string,
@@ -391,6 +450,16 @@ func DeleteEnvironmentVariableByKey(
 		return fmt.Errorf("environment variable %s not found", pterm.FgRed.Sprintf(key))
 	}
 
+	return deleteEnvironmentVariable(client, projectId, environmentId, serviceId, envVar)
+}
+
+func deleteEnvironmentVariable(
+	client *qovery.APIClient,
+	projectId string,
+	environmentId string,
+	serviceId string,
+	envVar *qovery.EnvironmentVariable,
+) error {
 	switch string(envVar.Scope) {
 	case "PROJECT":
 		_, err := client.ProjectEnvironmentVariableApi.DeleteProjectEnvironmentVariable(
@@ -456,6 +525,16 @@ func DeleteSecretByKey(
 		return fmt.Errorf("secret %s not found", pterm.FgRed.Sprintf(key))
 	}
 
+	return deleteSecret(client, projectId, environmentId, serviceId, secret)
+}
+
+func deleteSecret(
+	client *qovery.APIClient,
+	projectId string,
+	environmentId string,
+	serviceId string,
+	secret *qovery.Secret,
+) error {
 	switch string(secret.Scope) {
 	case "PROJECT":
 		_, err := client.ProjectSecretApi.DeleteProjectSecret(
@@ -466,7 +545,7 @@ func DeleteSecretByKey(
 
 		return err
 	case "ENVIRONMENT":
-		_, err := client.EnvironmentVariableApi.DeleteEnvironmentEnvironmentVariable(
+		_, err := client.EnvironmentSecretApi.DeleteEnvironmentSecret(
 			context.Background(),
 			environmentId,
 			secret.Id,
@@ -523,6 +602,382 @@ func DeleteByKey(
 	return fmt.Errorf("environment variable or secret %s not found", pterm.FgRed.Sprintf(key))
 }
 
+func ListEnvironmentVariablesByScope(
+	client *qovery.APIClient,
+	projectId string,
+	environmentId string,
+	scope string,
+) ([]qovery.EnvironmentVariable, error) {
+	switch strings.ToUpper(scope) {
+	case "PROJECT":
+		res, _, err := client.ProjectEnvironmentVariableApi.ListProjectEnvironmentVariable(context.Background(), projectId).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Results, nil
+	case "ENVIRONMENT":
+		res, _, err := client.EnvironmentVariableApi.ListEnvironmentEnvironmentVariable(context.Background(), environmentId).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Results, nil
+	}
+
+	return nil, errors.New("invalid scope")
+}
+
+func ListSecretsByScope(
+	client *qovery.APIClient,
+	projectId string,
+	environmentId string,
+	scope string,
+) ([]qovery.Secret, error) {
+	switch strings.ToUpper(scope) {
+	case "PROJECT":
+		res, _, err := client.ProjectSecretApi.ListProjectSecrets(context.Background(), projectId).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Results, nil
+	case "ENVIRONMENT":
+		res, _, err := client.EnvironmentSecretApi.ListEnvironmentSecrets(context.Background(), environmentId).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Results, nil
+	}
+
+	return nil, errors.New("invalid scope")
+}
+
+// DeleteByKeyAndScope deletes a project or environment variable or secret without going through a service
+func DeleteByKeyAndScope(
+	client *qovery.APIClient,
+	projectId string,
+	environmentId string,
+	key string,
+	scope string,
+) error {
+	envVars, err := ListEnvironmentVariablesByScope(client, projectId, environmentId, scope)
+	if err != nil {
+		return err
+	}
+
+	envVar := FindEnvironmentVariableByKey(key, envVars)
+	if envVar != nil {
+		return deleteEnvironmentVariable(client, projectId, environmentId, "", envVar)
+	}
+
+	secrets, err := ListSecretsByScope(client, projectId, environmentId, scope)
+	if err != nil {
+		return err
+	}
+
+	secret := FindSecretByKey(key, secrets)
+	if secret != nil {
+		return deleteSecret(client, projectId, environmentId, "", secret)
+	}
+
+	return fmt.Errorf("environment variable or secret %s not found", pterm.FgRed.Sprintf(key))
+}
+
+// ReadValue returns the value passed with --value, --value-from-file or --value-from-stdin.
+// Reading the value from a file or stdin keeps secrets out of the shell history.
+func ReadValue(value string, valueFromFile string, valueFromStdin bool) (string, error) {
+	sources := 0
+	if value != "" {
+		sources++
+	}
+	if valueFromFile != "" {
+		sources++
+	}
+	if valueFromStdin {
+		sources++
+	}
+
+	if sources == 0 {
+		return "", errors.New("a value is required: use --value, --value-from-file or --value-from-stdin")
+	}
+
+	if sources > 1 {
+		return "", errors.New("--value, --value-from-file and --value-from-stdin are mutually exclusive")
+	}
+
+	var bytes []byte
+	var err error
+
+	if valueFromFile != "" {
+		bytes, err = os.ReadFile(valueFromFile)
+	} else if valueFromStdin {
+		bytes, err = io.ReadAll(os.Stdin)
+	} else {
+		return value, nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	// drop the trailing newline added by editors and `echo`
+	return strings.TrimSuffix(strings.TrimSuffix(string(bytes), "\n"), "\r"), nil
+}
+
+func UpdateEnvironmentVariable(
+	client *qovery.APIClient,
+	projectId string,
+	environmentId string,
+	serviceId string,
+	envVar *qovery.EnvironmentVariable,
+	value string,
+) error {
+	if envVar.AliasedVariable != nil {
+		return fmt.Errorf("%s is an alias of %s, update %s instead", pterm.FgRed.Sprintf(envVar.Key), envVar.AliasedVariable.Key, envVar.AliasedVariable.Key)
+	}
+
+	req := *qovery.NewEnvironmentVariableEditRequest(envVar.Key, value)
+
+	switch string(envVar.Scope) {
+	case "PROJECT":
+		_, _, err := client.ProjectEnvironmentVariableApi.EditProjectEnvironmentVariable(
+			context.Background(),
+			projectId,
+			envVar.Id,
+		).EnvironmentVariableEditRequest(req).Execute()
+
+		return err
+	case "ENVIRONMENT":
+		_, _, err := client.EnvironmentVariableApi.EditEnvironmentEnvironmentVariable(
+			context.Background(),
+			environmentId,
+			envVar.Id,
+		).EnvironmentVariableEditRequest(req).Execute()
+
+		return err
+	case "APPLICATION":
+		_, _, err := client.ApplicationEnvironmentVariableApi.EditApplicationEnvironmentVariable(
+			context.Background(),
+			serviceId,
+			envVar.Id,
+		).EnvironmentVariableEditRequest(req).Execute()
+
+		return err
+	case "JOB":
+		_, _, err := client.JobEnvironmentVariableApi.EditJobEnvironmentVariable(
+			context.Background(),
+			serviceId,
+			envVar.Id,
+		).EnvironmentVariableEditRequest(req).Execute()
+
+		return err
+	case "CONTAINER":
+		_, _, err := client.ContainerEnvironmentVariableApi.EditContainerEnvironmentVariable(
+			context.Background(),
+			serviceId,
+			envVar.Id,
+		).EnvironmentVariableEditRequest(req).Execute()
+
+		return err
+	}
+
+	return errors.New("invalid scope")
+}
+
+func UpdateSecret(
+	client *qovery.APIClient,
+	projectId string,
+	environmentId string,
+	serviceId string,
+	secret *qovery.Secret,
+	value string,
+) error {
+	if secret.AliasedSecret != nil {
+		return fmt.Errorf("%s is an alias of %s, update %s instead", pterm.FgRed.Sprintf(secret.Key), secret.AliasedSecret.Key, secret.AliasedSecret.Key)
+	}
+
+	req := *qovery.NewSecretEditRequest(value, secret.Key)
+
+	switch string(secret.Scope) {
+	case "PROJECT":
+		_, _, err := client.ProjectSecretApi.EditProjectSecret(
+			context.Background(),
+			projectId,
+			secret.Id,
+		).SecretEditRequest(req).Execute()
+
+		return err
+	case "ENVIRONMENT":
+		_, _, err := client.EnvironmentSecretApi.EditEnvironmentSecret(
+			context.Background(),
+			environmentId,
+			secret.Id,
+		).SecretEditRequest(req).Execute()
+
+		return err
+	case "APPLICATION":
+		_, _, err := client.ApplicationSecretApi.EditApplicationSecret(
+			context.Background(),
+			serviceId,
+			secret.Id,
+		).SecretEditRequest(req).Execute()
+
+		return err
+	case "JOB":
+		_, _, err := client.JobSecretApi.EditJobSecret(
+			context.Background(),
+			serviceId,
+			secret.Id,
+		).SecretEditRequest(req).Execute()
+
+		return err
+	case "CONTAINER":
+		_, _, err := client.ContainerSecretApi.EditContainerSecret(
+			context.Background(),
+			serviceId,
+			secret.Id,
+		).SecretEditRequest(req).Execute()
+
+		return err
+	}
+
+	return errors.New("invalid scope")
+}
+
+// UpdateByKey edits the value of a service variable or secret in place, keeping the aliases pointing at it
+func UpdateByKey(
+	client *qovery.APIClient,
+	projectId string,
+	environmentId string,
+	serviceId string,
+	serviceType ServiceType,
+	key string,
+	value string,
+) error {
+	envVars, err := ListEnvironmentVariables(client, serviceId, serviceType)
+	if err != nil {
+		return err
+	}
+
+	envVar := findMostSpecificEnvironmentVariableByKey(key, envVars)
+	if envVar != nil {
+		return UpdateEnvironmentVariable(client, projectId, environmentId, serviceId, envVar, value)
+	}
+
+	secrets, err := ListSecrets(client, serviceId, serviceType)
+	if err != nil {
+		return err
+	}
+
+	secret := findMostSpecificSecretByKey(key, secrets)
+	if secret != nil {
+		return UpdateSecret(client, projectId, environmentId, serviceId, secret, value)
+	}
+
+	return fmt.Errorf("environment variable or secret %s not found", pterm.FgRed.Sprintf(key))
+}
+
+func UpdateByKeyAndScope(
+	client *qovery.APIClient,
+	projectId string,
+	environmentId string,
+	key string,
+	value string,
+	scope string,
+) error {
+	envVars, err := ListEnvironmentVariablesByScope(client, projectId, environmentId, scope)
+	if err != nil {
+		return err
+	}
+
+	envVar := findMostSpecificEnvironmentVariableByKey(key, envVars)
+	if envVar != nil {
+		return UpdateEnvironmentVariable(client, projectId, environmentId, "", envVar, value)
+	}
+
+	secrets, err := ListSecretsByScope(client, projectId, environmentId, scope)
+	if err != nil {
+		return err
+	}
+
+	secret := findMostSpecificSecretByKey(key, secrets)
+	if secret != nil {
+		return UpdateSecret(client, projectId, environmentId, "", secret, value)
+	}
+
+	return fmt.Errorf("environment variable or secret %s not found", pterm.FgRed.Sprintf(key))
+}
+
+// DeleteAliasByKey deletes the alias named alias, leaving the aliased variable or secret untouched
+func DeleteAliasByKey(
+	client *qovery.APIClient,
+	projectId string,
+	environmentId string,
+	serviceId string,
+	serviceType ServiceType,
+	alias string,
+) error {
+	envVars, err := ListEnvironmentVariables(client, serviceId, serviceType)
+	if err != nil {
+		return err
+	}
+
+	for _, envVar := range envVars {
+		if envVar.Key == alias && envVar.AliasedVariable != nil {
+			return deleteEnvironmentVariable(client, projectId, environmentId, serviceId, &envVar)
+		}
+	}
+
+	secrets, err := ListSecrets(client, serviceId, serviceType)
+	if err != nil {
+		return err
+	}
+
+	for _, secret := range secrets {
+		if secret.Key == alias && secret.AliasedSecret != nil {
+			return deleteSecret(client, projectId, environmentId, serviceId, &secret)
+		}
+	}
+
+	return fmt.Errorf("alias %s not found", pterm.FgRed.Sprintf(alias))
+}
+
+// DeleteOverrideByKey deletes the override of key, the overridden variable or secret value applies again
+func DeleteOverrideByKey(
+	client *qovery.APIClient,
+	projectId string,
+	environmentId string,
+	serviceId string,
+	serviceType ServiceType,
+	key string,
+) error {
+	envVars, err := ListEnvironmentVariables(client, serviceId, serviceType)
+	if err != nil {
+		return err
+	}
+
+	for _, envVar := range envVars {
+		if envVar.Key == key && envVar.OverriddenVariable != nil {
+			return deleteEnvironmentVariable(client, projectId, environmentId, serviceId, &envVar)
+		}
+	}
+
+	secrets, err := ListSecrets(client, serviceId, serviceType)
+	if err != nil {
+		return err
+	}
+
+	for _, secret := range secrets {
+		if secret.Key == key && secret.OverriddenSecret != nil {
+			return deleteSecret(client, projectId, environmentId, serviceId, &secret)
+		}
+	}
+
+	return fmt.Errorf("override %s not found", pterm.FgRed.Sprintf(key))
+}
+
 func CreateEnvironmentVariableAlias(
 	client *qovery.APIClient,
 	projectId string,
@@ -820,3 +1275,189 @@ func CreateOverride(
 
 	return fmt.Errorf("Environment variable or secret %s not found", pterm.FgRed.Sprintf(key))
 }
+
+// BuildEnvVarTree lists, across the environments and services of the project, the aliases and overrides
+// depending on the project or environment variable or secret named key
+func BuildEnvVarTree(
+	client *qovery.APIClient,
+	projectId string,
+	environmentId string,
+	key string,
+	scope string,
+) (*pterm.TreeNode, error) {
+	envVars, err := ListEnvironmentVariablesByScope(client, projectId, environmentId, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := ListSecretsByScope(client, projectId, environmentId, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	var parentId string
+	root := pterm.TreeNode{}
+
+	if envVar := FindEnvironmentVariableByKey(key, envVars); envVar != nil {
+		parentId = envVar.Id
+		root.Text = fmt.Sprintf("%s (Variable, %s)", envVar.Key, envVar.Scope)
+	} else if secret := FindSecretByKey(key, secrets); secret != nil {
+		parentId = secret.Id
+		root.Text = fmt.Sprintf("%s (Secret, %s)", secret.Key, secret.Scope)
+	} else {
+		return nil, fmt.Errorf("environment variable or secret %s not found", pterm.FgRed.Sprintf(key))
+	}
+
+	// aliases declared at the same scope than their parent
+	root.Children = append(root.Children, dependencyNodes(envVars, secrets, parentId)...)
+
+	var environments []qovery.Environment
+	if strings.ToUpper(scope) == "PROJECT" {
+		res, _, err := client.EnvironmentsApi.ListEnvironment(context.Background(), projectId).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		environments = res.GetResults()
+	} else {
+		environment, _, err := client.EnvironmentMainCallsApi.GetEnvironment(context.Background(), environmentId).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		environments = []qovery.Environment{*environment}
+	}
+
+	sort.Slice(environments, func(i, j int) bool {
+		return environments[i].Name < environments[j].Name
+	})
+
+	for _, environment := range environments {
+		environmentNode := pterm.TreeNode{Text: environment.Name}
+
+		if strings.ToUpper(scope) == "PROJECT" {
+			environmentEnvVars, err := ListEnvironmentVariablesByScope(client, projectId, environment.Id, "ENVIRONMENT")
+			if err != nil {
+				return nil, err
+			}
+
+			environmentSecrets, err := ListSecretsByScope(client, projectId, environment.Id, "ENVIRONMENT")
+			if err != nil {
+				return nil, err
+			}
+
+			environmentNode.Children = dependencyNodes(environmentEnvVars, environmentSecrets, parentId)
+		}
+
+		services, err := listEnvironmentServices(client, environment.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, service := range services {
+			serviceEnvVars, err := ListEnvironmentVariables(client, string(service.ID), service.Type)
+			if err != nil {
+				return nil, err
+			}
+
+			serviceSecrets, err := ListSecrets(client, string(service.ID), service.Type)
+			if err != nil {
+				return nil, err
+			}
+
+			// project and environment variables are also returned for each service, only keep the service ones
+			var ownEnvVars []qovery.EnvironmentVariable
+			for _, envVar := range serviceEnvVars {
+				if envVar.Scope != "PROJECT" && envVar.Scope != "ENVIRONMENT" {
+					ownEnvVars = append(ownEnvVars, envVar)
+				}
+			}
+
+			var ownSecrets []qovery.Secret
+			for _, secret := range serviceSecrets {
+				if secret.Scope != "PROJECT" && secret.Scope != "ENVIRONMENT" {
+					ownSecrets = append(ownSecrets, secret)
+				}
+			}
+
+			children := dependencyNodes(ownEnvVars, ownSecrets, parentId)
+			if len(children) > 0 {
+				environmentNode.Children = append(environmentNode.Children, pterm.TreeNode{Text: string(service.Name), Children: children})
+			}
+		}
+
+		if len(environmentNode.Children) > 0 {
+			root.Children = append(root.Children, environmentNode)
+		}
+	}
+
+	return &root, nil
+}
+
+func dependencyNodes(envVars []qovery.EnvironmentVariable, secrets []qovery.Secret, parentId string) []pterm.TreeNode {
+	var nodes []pterm.TreeNode
+
+	for _, envVar := range envVars {
+		if envVar.AliasedVariable != nil && envVar.AliasedVariable.Id == parentId {
+			nodes = append(nodes, pterm.TreeNode{Text: fmt.Sprintf("%s (Variable Alias, %s)", envVar.Key, envVar.Scope)})
+		}
+
+		if envVar.OverriddenVariable != nil && envVar.OverriddenVariable.Id == parentId {
+			nodes = append(nodes, pterm.TreeNode{Text: fmt.Sprintf("%s (Variable Override, %s)", envVar.Key, envVar.Scope)})
+		}
+	}
+
+	for _, secret := range secrets {
+		if secret.AliasedSecret != nil && secret.AliasedSecret.Id == parentId {
+			nodes = append(nodes, pterm.TreeNode{Text: fmt.Sprintf("%s (Secret Alias, %s)", secret.Key, secret.Scope)})
+		}
+
+		if secret.OverriddenSecret != nil && secret.OverriddenSecret.Id == parentId {
+			nodes = append(nodes, pterm.TreeNode{Text: fmt.Sprintf("%s (Secret Override, %s)", secret.Key, secret.Scope)})
+		}
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Text < nodes[j].Text
+	})
+
+	return nodes
+}
+
+// listEnvironmentServices returns the services of an environment that can hold environment variables
+func listEnvironmentServices(client *qovery.APIClient, environmentId string) ([]Service, error) {
+	var services []Service
+
+	applications, _, err := client.ApplicationsApi.ListApplication(context.Background(), environmentId).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, application := range applications.GetResults() {
+		services = append(services, Service{ID: Id(application.Id), Name: Name(application.GetName()), Type: ApplicationType})
+	}
+
+	containers, _, err := client.ContainersApi.ListContainer(context.Background(), environmentId).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, container := range containers.GetResults() {
+		services = append(services, Service{ID: Id(container.Id), Name: Name(container.Name), Type: ContainerType})
+	}
+
+	jobs, _, err := client.JobsApi.ListJobs(context.Background(), environmentId).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, job := range jobs.GetResults() {
+		services = append(services, Service{ID: Id(job.Id), Name: Name(job.Name), Type: JobType})
+	}
+
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].Name < services[j].Name
+	})
+
+	return services, nil
+}
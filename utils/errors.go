@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/qovery/qovery-client-go"
+)
+
+// Exit codes of the CLI, scripts can rely on them
+const (
+	// ExitCodeError is returned for any failure without a more specific code
+	ExitCodeError = 1
+	// ExitCodeUsage is returned for invalid flags or arguments
+	ExitCodeUsage = 2
+	// ExitCodeUnauthorized is returned when the user is not signed in or is not allowed to perform the action
+	ExitCodeUnauthorized = 3
+	// ExitCodeNotFound is returned when an organization, project, environment, service... does not exist
+	ExitCodeNotFound = 4
+	// ExitCodeApiError is returned when the Qovery API rejects a request or fails
+	ExitCodeApiError = 5
+)
+
+// UsageError is returned for invalid flags or arguments
+type UsageError struct {
+	Err error
+}
+
+func (e *UsageError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *UsageError) Unwrap() error {
+	return e.Err
+}
+
+// AuthError is returned when the user is not signed in, or the credentials can't be refreshed
+type AuthError struct {
+	Err error
+}
+
+func (e *AuthError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
+// CommandExitError is returned when a remote command fails, the CLI exits with its code without printing anything
+// more as the command printed its own errors
+type CommandExitError struct {
+	Code int
+}
+
+func (e *CommandExitError) Error() string {
+	return fmt.Sprintf("command exited with code %d", e.Code)
+}
+
+// HintError is an error followed by a hint on how to fix it, e.g. the command listing the available resources
+type HintError struct {
+	Err  error
+	Hint string
+}
+
+func (e *HintError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *HintError) Unwrap() error {
+	return e.Err
+}
+
+func WithHint(err error, hint string) error {
+	return &HintError{Err: err, Hint: hint}
+}
+
+// ExitCode maps an error returned by a command to the exit code of the CLI, 0 only when there is no error
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var commandExitError *CommandExitError
+	if errors.As(err, &commandExitError) {
+		return commandExitError.Code
+	}
+
+	var usageError *UsageError
+	if errors.As(err, &usageError) {
+		return ExitCodeUsage
+	}
+
+	var authError *AuthError
+	if errors.As(err, &authError) {
+		return ExitCodeUnauthorized
+	}
+
+	var notFoundError *NotFoundError
+	if errors.As(err, &notFoundError) {
+		return ExitCodeNotFound
+	}
+
+	var apiError *qovery.GenericOpenAPIError
+	if errors.As(err, &apiError) {
+		switch apiErrorStatusCode(apiError) {
+		case 401, 403:
+			return ExitCodeUnauthorized
+		case 404:
+			return ExitCodeNotFound
+		default:
+			return ExitCodeApiError
+		}
+	}
+
+	return ExitCodeError
+}
+
+// PrintlnCommandError prints an error returned by a command, with the body of the response when the API rejected
+// the request as it tells why, e.g. which field is invalid
+func PrintlnCommandError(err error) {
+	var commandExitError *CommandExitError
+	if errors.As(err, &commandExitError) {
+		return
+	}
+
+	PrintlnError(err)
+
+	var apiError *qovery.GenericOpenAPIError
+	if errors.As(err, &apiError) {
+		if body := strings.TrimSpace(string(apiError.Body())); body != "" {
+			fmt.Println(body)
+		}
+	}
+
+	var hintError *HintError
+	if errors.As(err, &hintError) {
+		PrintlnInfo(hintError.Hint)
+	}
+}
+
+// apiErrorStatusCode reads the status code from the error message of the generated client, e.g. "404 Not Found"
+func apiErrorStatusCode(err *qovery.GenericOpenAPIError) int {
+	status, _, _ := strings.Cut(err.Error(), " ")
+	code, _ := strconv.Atoi(status)
+	return code
+}
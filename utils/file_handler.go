@@ -1,30 +1,28 @@
 package utils
 
 import (
+	"fmt"
 	"os"
 	"runtime"
 
 	log "github.com/sirupsen/logrus"
 )
 
-func WriteInFile(clusterId string, fileName string, content []byte) string {
+func WriteInFile(clusterId string, fileName string, content []byte) (string, error) {
 	fullPath := GetFullPath(clusterId)
 	if _, err := os.Stat(fullPath); os.IsNotExist(err) {
 		err := os.Mkdir(fullPath, 0777)
 		if err != nil {
-			log.Error("Couldn't create folder : " + err.Error())
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return "", fmt.Errorf("Couldn't create folder : %s", err)
 		}
 	}
 
 	err := os.WriteFile(fullPath+fileName, content, 0777)
 	if err != nil {
-		log.Error("Couldn't write file : " + err.Error())
-		return ""
+		return "", fmt.Errorf("Couldn't write file : %s", err)
 	}
 
-	return fullPath + fileName
+	return fullPath + fileName, nil
 }
 
 func GetFullPath(clusterId string) string {
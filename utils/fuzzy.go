@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/lithammer/fuzzysearch/fuzzy"
+	"github.com/manifoldco/promptui"
+	"golang.org/x/term"
+)
+
+// maximum number of names suggested when a resource is not found
+const maxSuggestions = 5
+
+// findByName looks a resource up by ID or exact name, then by case-insensitive name. Several resources matching the
+// name case-insensitively are offered in a prompt on a terminal, and nil is returned otherwise: the commands report
+// the miss with the closest names, they never pick a resource which merely looks like name as delete must act on
+// the one given.
+func findByName[T any](resources []T, name string, idOf func(*T) string, nameOf func(*T) string) *T {
+	for i := range resources {
+		if nameOf(&resources[i]) == name || idOf(&resources[i]) == name {
+			return &resources[i]
+		}
+	}
+
+	var caseInsensitiveMatches []int
+	for i := range resources {
+		if strings.EqualFold(nameOf(&resources[i]), name) {
+			caseInsensitiveMatches = append(caseInsensitiveMatches, i)
+		}
+	}
+	if len(caseInsensitiveMatches) == 1 {
+		return &resources[caseInsensitiveMatches[0]]
+	}
+	if len(caseInsensitiveMatches) == 0 || !IsInteractive() {
+		return nil
+	}
+
+	var items []string
+	for _, i := range caseInsensitiveMatches {
+		items = append(items, fmt.Sprintf("%s (%s)", nameOf(&resources[i]), idOf(&resources[i])))
+	}
+
+	prompt := promptui.Select{
+		Label: fmt.Sprintf("Several resources match %s, select one", name),
+		Items: items,
+	}
+	selected, _, err := prompt.Run()
+	if err != nil {
+		return nil
+	}
+
+	return &resources[caseInsensitiveMatches[selected]]
+}
+
+// closestNames returns the names containing the letters of name in order, or within a few typos of it,
+// closest first
+func closestNames(name string, names []string) []string {
+	type candidate struct {
+		name     string
+		distance int
+	}
+
+	maxTypos := len(name) / 3
+	if maxTypos < 2 {
+		maxTypos = 2
+	}
+
+	var candidates []candidate
+	seen := make(map[string]bool)
+	for _, target := range names {
+		if seen[target] {
+			continue
+		}
+
+		distance := fuzzy.LevenshteinDistance(strings.ToLower(name), strings.ToLower(target))
+		if distance <= maxTypos || fuzzy.MatchNormalizedFold(name, target) {
+			seen[target] = true
+			candidates = append(candidates, candidate{name: target, distance: distance})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+
+	var suggestions []string
+	for i := 0; i < len(candidates) && i < maxSuggestions; i++ {
+		suggestions = append(suggestions, candidates[i].name)
+	}
+
+	return suggestions
+}
+
+// IsInteractive tells if the user can answer a prompt, i.e. not in a CI job or a pipe
+func IsInteractive() bool {
+	return term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
+}
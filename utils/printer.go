@@ -26,6 +26,10 @@ func Println(text string) {
 }
 
 func PrintlnContext() error {
+	profiles, err := CurrentProfiles()
+	if err != nil {
+		return err
+	}
 	_, oName, err := CurrentOrganization()
 	if err != nil {
 		return err
@@ -43,6 +47,7 @@ func PrintlnContext() error {
 		return err
 	}
 	_ = pterm.DefaultTable.WithData(pterm.TableData{
+		{"Profile", ActiveProfileName(profiles)},
 		{"Organization", string(oName)},
 		{"Project", string(pName)},
 		{"Environment", string(eName)},
@@ -0,0 +1,200 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/qovery/qovery-client-go"
+	"gopkg.in/yaml.v3"
+)
+
+// ProjectContextFileName is looked up in the current directory and its parents
+const ProjectContextFileName = ".qovery.yml"
+
+// ContextSelection pins the organization, project, environment and service, e.g. in a .qovery.yml file.
+// Each level accepts a name or an ID.
+type ContextSelection struct {
+	Organization string `yaml:"organization"`
+	Project      string `yaml:"project"`
+	Environment  string `yaml:"environment"`
+	Service      string `yaml:"service"`
+	// ServiceType is only known when the selection comes from a console URL
+	ServiceType ServiceType `yaml:"-"`
+}
+
+// resolved once per CLI invocation
+var effectiveContext *QoveryContext
+
+// FindProjectContextFile returns the path of the closest .qovery.yml, or an empty string if there is none
+func FindProjectContextFile() string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+
+	for {
+		path := filepath.Join(dir, ProjectContextFileName)
+		if pathExists(path) {
+			return path
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+func ReadProjectContextFile(path string) (*ContextSelection, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file := ContextSelection{}
+	err = yaml.Unmarshal(bytes, &file)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %s", path, err)
+	}
+
+	return &file, nil
+}
+
+// EffectiveContext is the global context overridden by the levels pinned in .qovery.yml.
+// When a pinned level differs from the global context, the levels below it which are not pinned are unset
+// rather than mixed with a selection belonging to another parent.
+func EffectiveContext() (QoveryContext, error) {
+	if effectiveContext != nil {
+		return *effectiveContext, nil
+	}
+
+	ctx, err := CurrentContext()
+	if err != nil {
+		return ctx, err
+	}
+
+	path := FindProjectContextFile()
+	if path == "" {
+		return ctx, nil
+	}
+
+	file, err := ReadProjectContextFile(path)
+	if err != nil {
+		return ctx, err
+	}
+
+	ctx, err = ResolveContextSelection(ctx, *file)
+	if err != nil {
+		return ctx, fmt.Errorf("%s: %s", path, err)
+	}
+
+	effectiveContext = &ctx
+	return ctx, nil
+}
+
+// SetEffectiveContext overrides the context for the rest of the invocation, e.g. with the resources of --url
+func SetEffectiveContext(ctx QoveryContext) {
+	effectiveContext = &ctx
+}
+
+// resetEffectiveContext drops the resolved context after a selection changes, so that it is resolved again from the
+// stored context and .qovery.yml
+func resetEffectiveContext() {
+	effectiveContext = nil
+}
+
+// ResolveContextSelection applies the selected levels to ctx, looking them up by name or ID
+func ResolveContextSelection(ctx QoveryContext, selection ContextSelection) (QoveryContext, error) {
+	tokenType, token, err := GetAccessToken()
+	if err != nil {
+		return ctx, err
+	}
+
+	client := GetQoveryClient(tokenType, token)
+	changed := false
+
+	if selection.Organization != "" {
+		organizations, _, err := client.OrganizationMainCallsApi.ListOrganization(context.Background()).Execute()
+		if err != nil {
+			return ctx, err
+		}
+
+		organization := findByName(organizations.GetResults(), selection.Organization, (*qovery.Organization).GetId, (*qovery.Organization).GetName)
+		if organization == nil {
+			return ctx, &NotFoundError{Level: "organization", Name: selection.Organization, Candidates: namesOf(organizations.GetResults(), (*qovery.Organization).GetName)}
+		}
+
+		changed = Id(organization.Id) != ctx.OrganizationId
+		ctx.OrganizationId = Id(organization.Id)
+		ctx.OrganizationName = Name(organization.Name)
+	}
+
+	if selection.Project != "" {
+		projects, _, err := client.ProjectsApi.ListProject(context.Background(), string(ctx.OrganizationId)).Execute()
+		if err != nil {
+			return ctx, err
+		}
+
+		project := findByName(projects.GetResults(), selection.Project, (*qovery.Project).GetId, (*qovery.Project).GetName)
+		if project == nil {
+			return ctx, &NotFoundError{Level: "project", Name: selection.Project, Parent: "organization " + string(ctx.OrganizationName), Candidates: namesOf(projects.GetResults(), (*qovery.Project).GetName)}
+		}
+
+		changed = changed || Id(project.Id) != ctx.ProjectId
+		ctx.ProjectId = Id(project.Id)
+		ctx.ProjectName = Name(project.Name)
+	} else if changed {
+		ctx.ProjectId, ctx.ProjectName = "", ""
+	}
+
+	if selection.Environment != "" {
+		environments, _, err := client.EnvironmentsApi.ListEnvironment(context.Background(), string(ctx.ProjectId)).Execute()
+		if err != nil {
+			return ctx, err
+		}
+
+		environment := findByName(environments.GetResults(), selection.Environment, (*qovery.Environment).GetId, (*qovery.Environment).GetName)
+		if environment == nil {
+			return ctx, &NotFoundError{Level: "environment", Name: selection.Environment, Parent: "project " + string(ctx.ProjectName), Candidates: namesOf(environments.GetResults(), (*qovery.Environment).GetName)}
+		}
+
+		changed = changed || Id(environment.Id) != ctx.EnvironmentId
+		ctx.EnvironmentId = Id(environment.Id)
+		ctx.EnvironmentName = Name(environment.Name)
+	} else if changed {
+		ctx.EnvironmentId, ctx.EnvironmentName = "", ""
+	}
+
+	if selection.Service != "" {
+		services, err := listEnvironmentServices(client, string(ctx.EnvironmentId))
+		if err != nil {
+			return ctx, err
+		}
+
+		databases, _, err := client.DatabasesApi.ListDatabase(context.Background(), string(ctx.EnvironmentId)).Execute()
+		if err != nil {
+			return ctx, err
+		}
+
+		for _, database := range databases.GetResults() {
+			services = append(services, Service{ID: Id(database.Id), Name: Name(database.Name), Type: DatabaseType})
+		}
+
+		serviceName := func(service *Service) string { return string(service.Name) }
+		service := findByName(services, selection.Service, func(service *Service) string { return string(service.ID) }, serviceName)
+		if service == nil {
+			return ctx, &NotFoundError{Level: "service", Name: selection.Service, Parent: "environment " + string(ctx.EnvironmentName), Candidates: namesOf(services, serviceName)}
+		}
+
+		ctx.ServiceId = service.ID
+		ctx.ServiceName = service.Name
+		ctx.ServiceType = service.Type
+	} else if changed {
+		ctx.ServiceId, ctx.ServiceName, ctx.ServiceType = "", "", ""
+	}
+
+	return ctx, nil
+}
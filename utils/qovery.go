@@ -91,12 +91,10 @@ func SelectOrganization() (*Organization, error) {
 func SelectAndSetOrganization() (*Organization, error) {
 	selectedOrganization, err := SelectOrganization()
 	if err != nil {
-		PrintlnError(err)
 		return nil, err
 	}
 	err = SetOrganization(selectedOrganization)
 	if err != nil {
-		PrintlnError(err)
 		return nil, err
 	}
 
@@ -167,7 +165,6 @@ func SelectProject(organizationID Id) (*Project, error) {
 	}
 	_, selectedProject, err := prompt.Run()
 	if err != nil {
-		PrintlnError(err)
 		return nil, err
 	}
 
@@ -184,7 +181,6 @@ func SelectAndSetProject(organizationID Id) (*Project, error) {
 	}
 	err = SetProject(selectedProject)
 	if err != nil {
-		PrintlnError(err)
 		return nil, err
 	}
 
@@ -256,7 +252,6 @@ func SelectEnvironment(projectID Id) (*Environment, error) {
 	}
 	_, selectedEnvironment, err := prompt.Run()
 	if err != nil {
-		PrintlnError(err)
 		return nil, err
 	}
 	return &Environment{
@@ -269,13 +264,11 @@ func SelectEnvironment(projectID Id) (*Environment, error) {
 func SelectAndSetEnvironment(projectID Id) (*Environment, error) {
 	selectedEnvironment, err := SelectEnvironment(projectID)
 	if err != nil {
-		PrintlnError(err)
 		return nil, err
 	}
 
 	err = SetEnvironment(selectedEnvironment)
 	if err != nil {
-		PrintlnError(err)
 		return nil, err
 	}
 
@@ -467,7 +460,6 @@ func SelectService(environment Id) (*Service, error) {
 	}
 	_, selectedService, err := prompt.Run()
 	if err != nil {
-		PrintlnError(err)
 		return nil, err
 	}
 
@@ -478,11 +470,9 @@ func SelectService(environment Id) (*Service, error) {
 func SelectAndSetService(environment Id) (*Service, error) {
 	service, err := SelectService(environment)
 	if err != nil {
-		PrintlnError(err)
 		return nil, err
 	}
 	if err := SetService(service); err != nil {
-		PrintlnError(err)
 		return nil, err
 	}
 	return service, err
@@ -526,11 +516,43 @@ func ResetApplicationContext() error {
 	ctx.ServiceId = ""
 	ctx.ServiceType = ApplicationType
 
+	resetEffectiveContext()
 	err = StoreContext(ctx)
 
 	return err
 }
 
+// UnsetContextLevel clears a level of the context and the levels depending on it
+func UnsetContextLevel(level string) error {
+	ctx, err := CurrentContext()
+	if err != nil {
+		return err
+	}
+
+	switch level {
+	case "organization":
+		ctx.OrganizationName = ""
+		ctx.OrganizationId = ""
+		fallthrough
+	case "project":
+		ctx.ProjectName = ""
+		ctx.ProjectId = ""
+		fallthrough
+	case "environment":
+		ctx.EnvironmentName = ""
+		ctx.EnvironmentId = ""
+		fallthrough
+	case "service":
+		ctx.ServiceName = ""
+		ctx.ServiceId = ""
+		ctx.ServiceType = ""
+	default:
+		return fmt.Errorf("unknown context level %s, expected organization, project, environment or service", level)
+	}
+
+	return StoreContext(ctx)
+}
+
 type Container struct {
 	ID   Id
 	Name Name
@@ -585,12 +607,12 @@ func GetJobById(id string) (*Job, error) {
 	}, nil
 }
 
-func CheckAdminUrl() {
+func CheckAdminUrl() error {
 	if _, ok := os.LookupEnv("ADMIN_URL"); !ok {
-		log.Error("You must set the Qovery admin root url (ADMIN_URL).")
-		os.Exit(1)
-		panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+		return &UsageError{Err: errors.New("You must set the Qovery admin root url (ADMIN_URL).")}
 	}
+
+	return nil
 }
 
 func DeleteEnvironmentVariable(application Id, key string) error {
@@ -793,74 +815,36 @@ func GetStatusTextWithColor(s qovery.Status) string {
 	return statusMsg
 }
 
+// FindByOrganizationName and the other FindBy*Name functions match the ID of the resource as well as its name,
+// so that flags like --application can address a resource whose name is ambiguous or has changed. Names are
+// matched case-insensitively, and nil is returned when none matches: the commands then return a NotFoundError whose
+// candidates, given by ApplicationNames and the other *Names functions, are used to suggest the closest names.
 func FindByOrganizationName(organizations []qovery.Organization, name string) *qovery.Organization {
-	for _, o := range organizations {
-		if o.Name == name {
-			return &o
-		}
-	}
-
-	return nil
+	return findByName(organizations, name, (*qovery.Organization).GetId, (*qovery.Organization).GetName)
 }
 
 func FindByProjectName(projects []qovery.Project, name string) *qovery.Project {
-	for _, p := range projects {
-		if p.Name == name {
-			return &p
-		}
-	}
-
-	return nil
+	return findByName(projects, name, (*qovery.Project).GetId, (*qovery.Project).GetName)
 }
 
 func FindByEnvironmentName(environments []qovery.Environment, name string) *qovery.Environment {
-	for _, e := range environments {
-		if e.Name == name {
-			return &e
-		}
-	}
-
-	return nil
+	return findByName(environments, name, (*qovery.Environment).GetId, (*qovery.Environment).GetName)
 }
 
 func FindByApplicationName(applications []qovery.Application, name string) *qovery.Application {
-	for _, a := range applications {
-		if *a.Name == name {
-			return &a
-		}
-	}
-
-	return nil
+	return findByName(applications, name, (*qovery.Application).GetId, (*qovery.Application).GetName)
 }
 
 func FindByContainerName(containers []qovery.ContainerResponse, name string) *qovery.ContainerResponse {
-	for _, c := range containers {
-		if c.Name == name {
-			return &c
-		}
-	}
-
-	return nil
+	return findByName(containers, name, (*qovery.ContainerResponse).GetId, (*qovery.ContainerResponse).GetName)
 }
 
 func FindByJobName(jobs []qovery.JobResponse, name string) *qovery.JobResponse {
-	for _, j := range jobs {
-		if j.Name == name {
-			return &j
-		}
-	}
-
-	return nil
+	return findByName(jobs, name, (*qovery.JobResponse).GetId, (*qovery.JobResponse).GetName)
 }
 
 func FindByDatabaseName(databases []qovery.Database, name string) *qovery.Database {
-	for _, d := range databases {
-		if d.Name == name {
-			return &d
-		}
-	}
-
-	return nil
+	return findByName(databases, name, (*qovery.Database).GetId, (*qovery.Database).GetName)
 }
 
 func FindByCustomDomainName(customDomains []qovery.CustomDomain, name string) *qovery.CustomDomain {
@@ -873,16 +857,46 @@ func FindByCustomDomainName(customDomains []qovery.CustomDomain, name string) *q
 	return nil
 }
 
-func WatchEnvironment(envId string, finalServiceState qovery.StateEnum, client *qovery.APIClient) {
-	WatchEnvironmentWithOptions(envId, finalServiceState, client, false)
+func OrganizationNames(organizations []qovery.Organization) []string {
+	return namesOf(organizations, (*qovery.Organization).GetName)
+}
+
+func EnvironmentNames(environments []qovery.Environment) []string {
+	return namesOf(environments, (*qovery.Environment).GetName)
+}
+
+func ApplicationNames(applications []qovery.Application) []string {
+	return namesOf(applications, (*qovery.Application).GetName)
+}
+
+func ContainerNames(containers []qovery.ContainerResponse) []string {
+	return namesOf(containers, (*qovery.ContainerResponse).GetName)
+}
+
+func JobNames(jobs []qovery.JobResponse) []string {
+	return namesOf(jobs, (*qovery.JobResponse).GetName)
+}
+
+func DatabaseNames(databases []qovery.Database) []string {
+	return namesOf(databases, (*qovery.Database).GetName)
 }
 
-func WatchEnvironmentWithOptions(envId string, finalServiceState qovery.StateEnum, client *qovery.APIClient, displaySimpleText bool) {
+func CustomDomainNames(customDomains []qovery.CustomDomain) []string {
+	return namesOf(customDomains, (*qovery.CustomDomain).GetDomain)
+}
+
+func WatchEnvironment(envId string, finalServiceState qovery.StateEnum, client *qovery.APIClient) error {
+	return WatchEnvironmentWithOptions(envId, finalServiceState, client, false)
+}
+
+// WatchEnvironmentWithOptions prints the status of the environment until it reaches a terminal state, an error state
+// being returned as an error
+func WatchEnvironmentWithOptions(envId string, finalServiceState qovery.StateEnum, client *qovery.APIClient, displaySimpleText bool) error {
 	for {
 		status, _, err := client.EnvironmentMainCallsApi.GetEnvironmentStatus(context.Background(), envId).Execute()
 
 		if err != nil {
-			return
+			return err
 		}
 
 		statuses, _, _ := client.EnvironmentMainCallsApi.GetEnvironmentStatuses(context.Background(), envId).Execute()
@@ -907,25 +921,24 @@ func WatchEnvironmentWithOptions(envId string, finalServiceState qovery.StateEnu
 
 		if status.State == qovery.STATEENUM_RUNNING || status.State == qovery.STATEENUM_DELETED ||
 			status.State == qovery.STATEENUM_STOPPED || status.State == qovery.STATEENUM_CANCELED {
-			return
+			return nil
 		}
 
 		if strings.HasSuffix(string(status.State), "ERROR") {
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("environment is in %s state", status.State)
 		}
 
 		time.Sleep(3 * time.Second)
 	}
 }
 
-func WatchContainer(containerId string, envId string, client *qovery.APIClient) {
+func WatchContainer(containerId string, envId string, client *qovery.APIClient) error {
 out:
 	for {
 		status, _, err := client.ContainerMainCallsApi.GetContainerStatus(context.Background(), containerId).Execute()
 
 		if err != nil {
-			break
+			return err
 		}
 
 		switch WatchStatus(status) {
@@ -933,8 +946,7 @@ out:
 		case Stop:
 			break out
 		case Err:
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("container is in %s state", status.State)
 		}
 
 		time.Sleep(3 * time.Second)
@@ -943,16 +955,16 @@ out:
 	log.Println("Check environment status..")
 
 	// check status of environment
-	WatchEnvironmentWithOptions(envId, "unused", client, true)
+	return WatchEnvironmentWithOptions(envId, "unused", client, true)
 }
 
-func WatchApplication(applicationId string, envId string, client *qovery.APIClient) {
+func WatchApplication(applicationId string, envId string, client *qovery.APIClient) error {
 out:
 	for {
 		status, _, err := client.ApplicationMainCallsApi.GetApplicationStatus(context.Background(), applicationId).Execute()
 
 		if err != nil {
-			break
+			return err
 		}
 
 		switch WatchStatus(status) {
@@ -960,8 +972,7 @@ out:
 		case Stop:
 			break out
 		case Err:
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("application is in %s state", status.State)
 		}
 
 		time.Sleep(3 * time.Second)
@@ -970,16 +981,16 @@ out:
 	log.Println("Check environment status..")
 
 	// check status of environment
-	WatchEnvironmentWithOptions(envId, "unused", client, true)
+	return WatchEnvironmentWithOptions(envId, "unused", client, true)
 }
 
-func WatchDatabase(databaseId string, envId string, client *qovery.APIClient) {
+func WatchDatabase(databaseId string, envId string, client *qovery.APIClient) error {
 out:
 	for {
 		status, _, err := client.DatabaseMainCallsApi.GetDatabaseStatus(context.Background(), databaseId).Execute()
 
 		if err != nil {
-			break
+			return err
 		}
 
 		switch WatchStatus(status) {
@@ -987,8 +998,7 @@ out:
 		case Stop:
 			break out
 		case Err:
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("database is in %s state", status.State)
 		}
 
 		time.Sleep(3 * time.Second)
@@ -997,16 +1007,16 @@ out:
 	log.Println("Check environment status..")
 
 	// check status of environment
-	WatchEnvironmentWithOptions(envId, "unused", client, true)
+	return WatchEnvironmentWithOptions(envId, "unused", client, true)
 }
 
-func WatchJob(jobId string, envId string, client *qovery.APIClient) {
+func WatchJob(jobId string, envId string, client *qovery.APIClient) error {
 out:
 	for {
 		status, _, err := client.JobMainCallsApi.GetJobStatus(context.Background(), jobId).Execute()
 
 		if err != nil {
-			break
+			return err
 		}
 
 		switch WatchStatus(status) {
@@ -1014,8 +1024,7 @@ out:
 		case Stop:
 			break out
 		case Err:
-			os.Exit(1)
-			panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+			return fmt.Errorf("job is in %s state", status.State)
 		}
 
 		time.Sleep(3 * time.Second)
@@ -1024,7 +1033,7 @@ out:
 	log.Println("Check environment status..")
 
 	// check status of environment
-	WatchEnvironmentWithOptions(envId, "unused", client, true)
+	return WatchEnvironmentWithOptions(envId, "unused", client, true)
 }
 
 type Status int8
@@ -1104,16 +1113,14 @@ func GetServiceNameByIdAndType(client *qovery.APIClient, serviceId string, servi
 	}
 }
 
-func GetDeploymentStageId(client *qovery.APIClient, serviceId string) string {
+func GetDeploymentStageId(client *qovery.APIClient, serviceId string) (string, error) {
 	sourceDeploymentStage, _, err := client.DeploymentStageMainCallsApi.GetServiceDeploymentStage(context.Background(), serviceId).Execute()
 
 	if err != nil {
-		PrintlnError(err)
-		os.Exit(1)
-		panic("unreachable") // staticcheck false positive: https://staticcheck.io/docs/checks#SA5011
+		return "", err
 	}
 
-	return sourceDeploymentStage.Id
+	return sourceDeploymentStage.Id, nil
 }
 
 func DeployApplications(client *qovery.APIClient, envId string, applicationNames string, commitId string) error {
@@ -1279,7 +1286,7 @@ func CancelEnvironmentDeployment(client *qovery.APIClient, envId string, watchFl
 	}
 
 	if watchFlag {
-		WatchEnvironmentWithOptions(envId, qovery.STATEENUM_CANCELED, client, true)
+		return WatchEnvironmentWithOptions(envId, qovery.STATEENUM_CANCELED, client, true)
 	}
 
 	return nil
@@ -1360,3 +1367,68 @@ func CancelServiceDeployment(client *qovery.APIClient, envId string, serviceId s
 
 	return CancelServiceDeployment(client, envId, serviceId, serviceType, watchFlag)
 }
+
+// ListServiceInstances returns the running instances (pods) of an application, container or job
+func ListServiceInstances(client *qovery.APIClient, serviceId string, serviceType ServiceType) ([]qovery.Instance, error) {
+	var instances *qovery.InstanceResponseList
+	var err error
+
+	switch serviceType {
+	case ApplicationType:
+		instances, _, err = client.ApplicationMetricsApi.GetApplicationCurrentInstance(context.Background(), serviceId).Execute()
+	case ContainerType:
+		instances, _, err = client.ContainerMetricsApi.GetContainerCurrentInstance(context.Background(), serviceId).Execute()
+	case JobType:
+		instances, _, err = client.JobMetricsApi.GetJobCurrentInstance(context.Background(), serviceId).Execute()
+	default:
+		return nil, fmt.Errorf("instances of %s services can't be listed", serviceType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return instances.GetResults(), nil
+}
+
+// SelectServiceInstance prompts for one of the running instances of a service, when there are several
+func SelectServiceInstance(serviceId Id, serviceType ServiceType) (string, error) {
+	tokenType, token, err := GetAccessToken()
+	if err != nil {
+		return "", err
+	}
+
+	client := GetQoveryClient(tokenType, token)
+
+	instances, err := ListServiceInstances(client, string(serviceId), serviceType)
+	if err != nil {
+		return "", err
+	}
+
+	var instanceNames []string
+	for _, instance := range instances {
+		instanceNames = append(instanceNames, instance.GetName())
+	}
+
+	if len(instanceNames) < 1 {
+		return "", errors.New("No running instance found. ")
+	}
+
+	if len(instanceNames) == 1 {
+		return instanceNames[0], nil
+	}
+
+	fmt.Println("Instance:")
+	prompt := promptui.Select{
+		Items: instanceNames,
+		Searcher: func(input string, index int) bool {
+			return strings.Contains(strings.ToLower(instanceNames[index]), strings.ToLower(input))
+		},
+	}
+	_, selectedInstance, err := prompt.Run()
+	if err != nil {
+		return "", err
+	}
+
+	return selectedInstance, nil
+}
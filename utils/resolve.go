@@ -0,0 +1,211 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/qovery/qovery-client-go"
+)
+
+// at most this many candidates are listed in a NotFoundError
+const maxNotFoundCandidates = 10
+
+// NotFoundError is returned when an organization, project, environment or service given by name or ID does not
+// exist, or does not belong to its parent
+type NotFoundError struct {
+	// Level is organization, project, environment or service
+	Level string
+	Name  string
+	// Parent describes where the resource was looked for, e.g. "project backend", empty for organizations
+	Parent string
+	// Candidates are the names of the resources found instead, nil when they are not known
+	Candidates []string
+}
+
+func (e *NotFoundError) Error() string {
+	message := fmt.Sprintf("%s %s not found", e.Level, e.Name)
+	if e.Parent != "" {
+		message += " in " + e.Parent
+	}
+
+	switch suggestions := closestNames(e.Name, e.Candidates); {
+	case len(suggestions) > 0:
+		message += fmt.Sprintf(", did you mean: %s?", strings.Join(suggestions, ", "))
+	case e.Candidates == nil:
+		// nothing to suggest
+	case len(e.Candidates) == 0:
+		message += fmt.Sprintf(", there is no %s", e.Level)
+	case len(e.Candidates) > maxNotFoundCandidates:
+		message += fmt.Sprintf(", available: %s... (%d in total)", strings.Join(e.Candidates[:maxNotFoundCandidates], ", "), len(e.Candidates))
+	default:
+		message += ", available: " + strings.Join(e.Candidates, ", ")
+	}
+
+	return message
+}
+
+// resolvedResource is a resource given by name or ID, or taken from the context
+type resolvedResource struct {
+	id string
+	// label names the resource in errors
+	label string
+	// fromContext is set when the resource comes from the context, whose levels belong to each other
+	fromContext bool
+}
+
+// ResolveResourcesId returns the IDs of the organization, project and environment given by name or ID, the empty
+// ones being taken from the context. A resource given by ID is fetched to check that it belongs to its parent, or
+// decides of its parent when the parent comes from the context. A resource of the context below one which is given
+// is looked up in it, so an environment of the context which does not belong to the project given is reported
+// rather than used.
+func ResolveResourcesId(client *qovery.APIClient, organization string, project string, environment string) (string, string, string, error) {
+	o, p, err := resolveProject(client, organization, project)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	environment = strings.TrimSpace(environment)
+	var e resolvedResource
+	switch {
+	case environment == "":
+		id, name, err := CurrentEnvironment()
+		if err != nil {
+			return "", "", "", err
+		}
+
+		e = resolvedResource{id: string(id), label: string(name) + " (current context)", fromContext: true}
+		if p.fromContext {
+			return o.id, p.id, e.id, nil
+		}
+	case IsId(environment):
+		found, _, err := client.EnvironmentMainCallsApi.GetEnvironment(context.Background(), environment).Execute()
+		if err != nil {
+			return "", "", "", err
+		}
+
+		projectId := found.Project.GetId()
+		if projectId == p.id {
+			return o.id, p.id, found.Id, nil
+		}
+
+		// the environment given by ID decides of the project when none is given
+		if p.fromContext {
+			o, p, err = resolveProject(client, organization, projectId)
+			if err != nil {
+				return "", "", "", err
+			}
+
+			return o.id, p.id, found.Id, nil
+		}
+
+		// reported along the environments of the project given
+		e = resolvedResource{id: environment, label: environment}
+	default:
+		e = resolvedResource{id: environment, label: environment}
+	}
+
+	environments, _, err := client.EnvironmentsApi.ListEnvironment(context.Background(), p.id).Execute()
+	if err != nil {
+		return "", "", "", err
+	}
+
+	found := findByName(environments.GetResults(), e.id, (*qovery.Environment).GetId, (*qovery.Environment).GetName)
+	if found == nil {
+		return "", "", "", &NotFoundError{Level: "environment", Name: e.label, Parent: "project " + p.label, Candidates: namesOf(environments.GetResults(), (*qovery.Environment).GetName)}
+	}
+
+	return o.id, p.id, found.Id, nil
+}
+
+// ResolveProjectId is ResolveResourcesId for the commands working on a project, which need no environment
+func ResolveProjectId(client *qovery.APIClient, organization string, project string) (string, string, error) {
+	o, p, err := resolveProject(client, organization, project)
+	return o.id, p.id, err
+}
+
+func resolveProject(client *qovery.APIClient, organization string, project string) (resolvedResource, resolvedResource, error) {
+	organization = strings.TrimSpace(organization)
+	project = strings.TrimSpace(project)
+
+	var o resolvedResource
+	switch {
+	case organization == "":
+		id, name, err := CurrentOrganization()
+		if err != nil {
+			return o, o, err
+		}
+
+		o = resolvedResource{id: string(id), label: string(name), fromContext: true}
+	case IsId(organization):
+		o = resolvedResource{id: organization, label: organization}
+	default:
+		organizations, _, err := client.OrganizationMainCallsApi.ListOrganization(context.Background()).Execute()
+		if err != nil {
+			return o, o, err
+		}
+
+		found := findByName(organizations.GetResults(), organization, (*qovery.Organization).GetId, (*qovery.Organization).GetName)
+		if found == nil {
+			return o, o, &NotFoundError{Level: "organization", Name: organization, Candidates: namesOf(organizations.GetResults(), (*qovery.Organization).GetName)}
+		}
+
+		o = resolvedResource{id: found.Id, label: found.Name}
+	}
+
+	var p resolvedResource
+	switch {
+	case project == "":
+		id, name, err := CurrentProject()
+		if err != nil {
+			return o, p, err
+		}
+
+		p = resolvedResource{id: string(id), label: string(name) + " (current context)", fromContext: true}
+		if o.fromContext {
+			return o, p, nil
+		}
+	case IsId(project):
+		found, _, err := client.ProjectMainCallsApi.GetProject(context.Background(), project).Execute()
+		if err != nil {
+			return o, p, err
+		}
+
+		organizationId := found.Organization.GetId()
+		if organizationId == o.id {
+			return o, resolvedResource{id: found.Id, label: found.Name}, nil
+		}
+
+		// the project given by ID decides of the organization when none is given
+		if o.fromContext {
+			return resolvedResource{id: organizationId, label: organizationId}, resolvedResource{id: found.Id, label: found.Name}, nil
+		}
+
+		// reported along the projects of the organization given
+		p = resolvedResource{id: project, label: project}
+	default:
+		p = resolvedResource{id: project, label: project}
+	}
+
+	projects, _, err := client.ProjectsApi.ListProject(context.Background(), o.id).Execute()
+	if err != nil {
+		return o, p, err
+	}
+
+	found := findByName(projects.GetResults(), p.id, (*qovery.Project).GetId, (*qovery.Project).GetName)
+	if found == nil {
+		return o, p, &NotFoundError{Level: "project", Name: p.label, Parent: "organization " + o.label, Candidates: namesOf(projects.GetResults(), (*qovery.Project).GetName)}
+	}
+
+	// a project of the context found in the organization given keeps its environment of the context
+	return o, resolvedResource{id: found.Id, label: found.Name, fromContext: p.fromContext}, nil
+}
+
+func namesOf[T any](resources []T, nameOf func(*T) string) []string {
+	names := make([]string, len(resources))
+	for i := range resources {
+		names[i] = nameOf(&resources[i])
+	}
+
+	return names
+}
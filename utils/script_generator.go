@@ -5,12 +5,13 @@ type Var struct {
 	Value string
 }
 
-func GenerateExportEnvVarsScript(vars []Var, clusterId string) {
+func GenerateExportEnvVarsScript(vars []Var, clusterId string) error {
 	content := []byte("#!/bin/bash \n")
 	for _, variable := range vars {
 		line := []byte("echo 'export " + variable.Key + "=" + variable.Value + "'\n")
 		content = append(content, line...)
 	}
 
-	WriteInFile(clusterId, "script", content)
+	_, err := WriteInFile(clusterId, "script", content)
+	return err
 }
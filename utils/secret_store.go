@@ -0,0 +1,113 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/joho/godotenv"
+)
+
+const VaultReferencePrefix = "vault://"
+
+// IsVaultReference tells if value points to a Vault secret, e.g. vault://secret/data/my-app#DATABASE_PASSWORD
+func IsVaultReference(value string) bool {
+	return strings.HasPrefix(value, VaultReferencePrefix)
+}
+
+// NewVaultClient connects to the Vault configured through VAULT_ADDR and VAULT_TOKEN,
+// falling back on the token stored by 'vault login' in ~/.vault-token
+func NewVaultClient() (*api.Client, error) {
+	client, err := api.NewClient(api.DefaultConfig())
+	if err != nil {
+		return nil, fmt.Errorf("can't create Vault client: %s", err)
+	}
+
+	if client.Token() == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+
+		token, err := os.ReadFile(filepath.Join(home, ".vault-token"))
+		if err != nil {
+			return nil, errors.New("no Vault token found, set VAULT_TOKEN or run 'vault login'")
+		}
+
+		client.SetToken(strings.TrimSpace(string(token)))
+	}
+
+	return client, nil
+}
+
+// ResolveVaultReference reads the key of the Vault secret targeted by reference (vault://<path>#<key>).
+// Both KV v1 and KV v2 (path containing /data/) secret engines are supported.
+func ResolveVaultReference(client *api.Client, reference string) (string, error) {
+	path, key, found := strings.Cut(strings.TrimPrefix(reference, VaultReferencePrefix), "#")
+	if !found || path == "" || key == "" {
+		return "", fmt.Errorf("invalid Vault reference %s, expected %s<path>#<key>", reference, VaultReferencePrefix)
+	}
+
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return "", err
+	}
+
+	if secret == nil || secret.Data == nil {
+		return "", fmt.Errorf("Vault secret %s not found", path)
+	}
+
+	data := secret.Data
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		// KV v2 wraps the secret data
+		data = nested
+	}
+
+	value, ok := data[key]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in Vault secret %s", key, path)
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("key %s of Vault secret %s is not a string", key, path)
+	}
+
+	return str, nil
+}
+
+// IsSopsEncrypted tells if a dotenv file has been encrypted with SOPS (age, PGP or KMS)
+func IsSopsEncrypted(content []byte) bool {
+	for _, line := range strings.Split(string(content), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "sops_mac=") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DecryptSopsDotEnv decrypts a SOPS encrypted dotenv file with the local 'sops' binary,
+// the plaintext is only kept in memory
+func DecryptSopsDotEnv(path string) (map[string]string, error) {
+	sopsPath, err := exec.LookPath("sops")
+	if err != nil {
+		return nil, errors.New("'sops' must be installed to import SOPS encrypted files")
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(sopsPath, "--decrypt", "--input-type", "dotenv", "--output-type", "dotenv", path)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("can't decrypt %s with sops: %s", path, strings.TrimSpace(stderr.String()))
+	}
+
+	return godotenv.Unmarshal(stdout.String())
+}
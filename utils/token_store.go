@@ -0,0 +1,375 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/zalando/go-keyring"
+	"golang.org/x/crypto/scrypt"
+)
+
+const keyringService = "qovery-cli"
+const encryptedTokensFileName = "tokens.enc"
+const encryptedTokensKeyFileName = "tokens.key"
+
+// tokensFileHeader starts the tokens files of the current format, followed by the salt, the nonce and the ciphertext
+var tokensFileHeader = []byte("QOVERY-TOKENS\x02")
+
+const tokensSaltSize = 16
+
+// passphraseKey caches the key derived from the passphrase with its salt, for the CLI invocation
+var passphraseKey struct {
+	salt []byte
+	key  []byte
+}
+
+// file mode of everything written in ~/.qovery
+const privateFileMode = 0600
+const privateDirMode = 0700
+
+// selected once per CLI invocation
+var tokenStore TokenStore
+
+// GetTokenStore returns the OS keyring (Keychain, Credential Manager, Secret Service) when it is reachable,
+// and the encrypted file store otherwise, e.g. on headless boxes without a D-Bus session.
+// QOVERY_CLI_TOKEN_STORE=keyring|file forces one of them.
+func GetTokenStore() TokenStore {
+	if tokenStore != nil {
+		return tokenStore
+	}
+
+	switch os.Getenv("QOVERY_CLI_TOKEN_STORE") {
+	case "file":
+		tokenStore = encryptedFileTokenStore{}
+	case "keyring":
+		tokenStore = keyringTokenStore{}
+	default:
+		// reading a missing entry tells if the keyring is usable without prompting the user
+		_, err := keyring.Get(keyringService, "probe")
+		if err == nil || errors.Is(err, keyring.ErrNotFound) {
+			tokenStore = keyringTokenStore{}
+		} else {
+			tokenStore = encryptedFileTokenStore{}
+		}
+	}
+
+	return tokenStore
+}
+
+// TokenStoreDescription tells where the tokens are kept, and whether the file store actually encrypts them
+func TokenStoreDescription() string {
+	if _, ok := GetTokenStore().(keyringTokenStore); ok {
+		return "OS keyring"
+	}
+
+	if os.Getenv("QOVERY_CLI_TOKEN_PASSPHRASE") != "" {
+		return "~/.qovery/" + encryptedTokensFileName + ", encrypted with QOVERY_CLI_TOKEN_PASSPHRASE"
+	}
+
+	return "~/.qovery/" + encryptedTokensFileName + ", NOT protected: its key is stored next to it"
+}
+
+// TokenStoreWarning is printed when the tokens are written to the file store without a passphrase: anyone able to
+// read ~/.qovery can decrypt them, the key only hides them from a casual look
+func TokenStoreWarning() string {
+	if _, ok := GetTokenStore().(keyringTokenStore); ok || os.Getenv("QOVERY_CLI_TOKEN_PASSPHRASE") != "" {
+		return ""
+	}
+
+	return fmt.Sprintf("No OS keyring available: tokens are stored in ~/.qovery/%s with their key in ~/.qovery/%s, "+
+		"so they are not protected from anyone able to read ~/.qovery. Set QOVERY_CLI_TOKEN_PASSPHRASE to encrypt them.",
+		encryptedTokensFileName, encryptedTokensKeyFileName)
+}
+
+type keyringTokenStore struct{}
+
+func (keyringTokenStore) Get(profile string) (Tokens, error) {
+	tokens := Tokens{}
+
+	value, err := keyring.Get(keyringService, profile)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return tokens, nil
+	}
+	if err != nil {
+		return tokens, fmt.Errorf("can't read tokens from the OS keyring: %s", err)
+	}
+
+	err = json.Unmarshal([]byte(value), &tokens)
+	return tokens, err
+}
+
+func (keyringTokenStore) Set(profile string, tokens Tokens) error {
+	value, err := json.Marshal(tokens)
+	if err != nil {
+		return err
+	}
+
+	err = keyring.Set(keyringService, profile, string(value))
+	if err != nil {
+		return fmt.Errorf("can't write tokens to the OS keyring: %s", err)
+	}
+
+	return nil
+}
+
+func (keyringTokenStore) Delete(profile string) error {
+	err := keyring.Delete(keyringService, profile)
+	if err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		return fmt.Errorf("can't delete tokens from the OS keyring: %s", err)
+	}
+
+	return nil
+}
+
+// encryptedFileTokenStore keeps the tokens of all profiles in ~/.qovery/tokens.enc, encrypted with AES-GCM.
+// The key is derived from QOVERY_CLI_TOKEN_PASSPHRASE with scrypt and the salt stored in the header of the file when
+// the passphrase is set, otherwise it is generated in ~/.qovery/tokens.key, next to the tokens: without a passphrase
+// this is obfuscation, not protection, see TokenStoreWarning.
+// Files written by former versions, without header and with a key hashed from the passphrase, are rewritten in the
+// current format when read.
+type encryptedFileTokenStore struct{}
+
+func (s encryptedFileTokenStore) Get(profile string) (Tokens, error) {
+	all, err := s.readAll()
+	if err != nil {
+		return Tokens{}, err
+	}
+
+	return all[profile], nil
+}
+
+func (s encryptedFileTokenStore) Set(profile string, tokens Tokens) error {
+	all, err := s.readAll()
+	if err != nil {
+		return err
+	}
+
+	all[profile] = tokens
+
+	return s.writeAll(all)
+}
+
+func (s encryptedFileTokenStore) Delete(profile string) error {
+	all, err := s.readAll()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := all[profile]; !ok {
+		return nil
+	}
+
+	delete(all, profile)
+
+	return s.writeAll(all)
+}
+
+func (s encryptedFileTokenStore) readAll() (map[string]Tokens, error) {
+	all := make(map[string]Tokens)
+
+	path, err := qoveryFilePath(encryptedTokensFileName)
+	if err != nil {
+		return all, err
+	}
+
+	encrypted, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return all, nil
+	}
+	if err != nil {
+		return all, err
+	}
+
+	legacy := !bytes.HasPrefix(encrypted, tokensFileHeader)
+
+	var gcm cipher.AEAD
+	if legacy {
+		gcm, err = s.legacyCipher()
+	} else {
+		encrypted = encrypted[len(tokensFileHeader):]
+		if len(encrypted) < tokensSaltSize {
+			return all, fmt.Errorf("%s is corrupted, sign in again using 'qovery auth'", path)
+		}
+
+		var salt []byte
+		salt, encrypted = encrypted[:tokensSaltSize], encrypted[tokensSaltSize:]
+		gcm, err = s.cipher(salt)
+	}
+	if err != nil {
+		return all, err
+	}
+
+	if len(encrypted) < gcm.NonceSize() {
+		return all, fmt.Errorf("%s is corrupted, sign in again using 'qovery auth'", path)
+	}
+
+	nonce, ciphertext := encrypted[:gcm.NonceSize()], encrypted[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return all, fmt.Errorf("can't decrypt %s, check QOVERY_CLI_TOKEN_PASSPHRASE or sign in again using 'qovery auth'", path)
+	}
+
+	err = json.Unmarshal(plaintext, &all)
+	if err != nil {
+		return all, err
+	}
+
+	if legacy {
+		return all, s.writeAll(all)
+	}
+
+	return all, nil
+}
+
+func (s encryptedFileTokenStore) writeAll(all map[string]Tokens) error {
+	plaintext, err := json.Marshal(all)
+	if err != nil {
+		return err
+	}
+
+	// the salt of the file read is kept, deriving a key from a passphrase is slow on purpose
+	salt := passphraseKey.salt
+	if salt == nil {
+		salt = make([]byte, tokensSaltSize)
+		if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+			return err
+		}
+	}
+
+	gcm, err := s.cipher(salt)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+
+	path, err := qoveryFilePath(encryptedTokensFileName)
+	if err != nil {
+		return err
+	}
+
+	content := append(append(append([]byte{}, tokensFileHeader...), salt...), nonce...)
+
+	return writePrivateFile(path, gcm.Seal(content, nonce, plaintext, nil))
+}
+
+// cipher returns the cipher of the current format, whose key is derived from the passphrase and salt
+func (s encryptedFileTokenStore) cipher(salt []byte) (cipher.AEAD, error) {
+	var key []byte
+
+	if passphrase := os.Getenv("QOVERY_CLI_TOKEN_PASSPHRASE"); passphrase != "" {
+		if !bytes.Equal(passphraseKey.salt, salt) {
+			derived, err := scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, 32)
+			if err != nil {
+				return nil, err
+			}
+
+			passphraseKey.salt = salt
+			passphraseKey.key = derived
+		}
+		key = passphraseKey.key
+	} else {
+		var err error
+		key, err = s.fileKey()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return newGCM(key)
+}
+
+// legacyCipher returns the cipher of the files written by former versions, whose key is the SHA-256 of the passphrase
+func (s encryptedFileTokenStore) legacyCipher() (cipher.AEAD, error) {
+	if passphrase := os.Getenv("QOVERY_CLI_TOKEN_PASSPHRASE"); passphrase != "" {
+		sum := sha256.Sum256([]byte(passphrase))
+		return newGCM(sum[:])
+	}
+
+	key, err := s.fileKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return newGCM(key)
+}
+
+// fileKey returns the key stored in ~/.qovery/tokens.key, generated on first use
+func (encryptedFileTokenStore) fileKey() ([]byte, error) {
+	path, err := qoveryFilePath(encryptedTokensKeyFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		key = make([]byte, 32)
+		if _, err := io.ReadFull(rand.Reader, key); err != nil {
+			return nil, err
+		}
+
+		err = writePrivateFile(path, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func qoveryFilePath(name string) (string, error) {
+	dir, err := QoveryDirPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, name), nil
+}
+
+// writePrivateFile atomically replaces a file with one only readable by the current user: the content is written to
+// a temporary file of the same directory which is then renamed, so a concurrent invocation never reads a partial file
+func writePrivateFile(path string, content []byte) error {
+	// created with the private file mode
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(content); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}